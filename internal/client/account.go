@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// AccountDetails represents the details of the HubSpot account (portal) the
+// client is authenticated with
+type AccountDetails struct {
+	PortalID            int64  `json:"portalId"`
+	AccountType         string `json:"accountType"`
+	TimeZone            string `json:"timeZone"`
+	CompanyCurrency     string `json:"companyCurrency"`
+	UIDomain            string `json:"uiDomain"`
+	DataHostingLocation string `json:"dataHostingLocation"`
+}
+
+// GetAccountDetails retrieves the details of the account
+func (c *Client) GetAccountDetails(ctx context.Context) (*AccountDetails, error) {
+	resp, err := c.Get(ctx, c.routePath(RouteAccountInfo, "details"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account details: %w", err)
+	}
+
+	var details AccountDetails
+	if err := DecodeResponse(resp, &details); err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// PortalID returns the ID of the portal the client is authenticated with.
+// It is taken from the token info recorded by ValidateCredentials, or else
+// fetched from the account details on first use.
+func (c *Client) PortalID(ctx context.Context) (int64, error) {
+	if info := c.TokenInfo(); info != nil && info.HubID != 0 {
+		return info.HubID, nil
+	}
+
+	c.portalMu.Lock()
+	defer c.portalMu.Unlock()
+
+	if c.portalID != 0 {
+		return c.portalID, nil
+	}
+
+	details, err := c.GetAccountDetails(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine portal ID: %w", err)
+	}
+	c.portalID = details.PortalID
+
+	return c.portalID, nil
+}
+
+// ExpectedPortalID returns the portal the client must be authenticated with,
+// or zero if none is configured
+func (c *Client) ExpectedPortalID() int64 {
+	return c.expectedPortalID
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// AssociationType describes one association between two records
+type AssociationType struct {
+	Category string `json:"category"`
+	TypeID   int    `json:"typeId"`
+	Label    string `json:"label,omitempty"`
+}
+
+// Association categories
+const (
+	AssociationCategoryHubSpotDefined    = "HUBSPOT_DEFINED"
+	AssociationCategoryUserDefined       = "USER_DEFINED"
+	AssociationCategoryIntegratorDefined = "INTEGRATOR_DEFINED"
+)
+
+// AssociationSpec identifies an association type when creating or removing an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
+}
+
+// AssociatedObject represents a record associated with another record
+type AssociatedObject struct {
+	ToObjectID       int64             `json:"toObjectId"`
+	AssociationTypes []AssociationType `json:"associationTypes"`
+}
+
+// associationListResponse represents a page of associated records
+type associationListResponse struct {
+	Results []AssociatedObject `json:"results"`
+	Paging  *Paging            `json:"paging"`
+}
+
+// associationLabelsResponse represents the association types defined between two object types
+type associationLabelsResponse struct {
+	Results []AssociationType `json:"results"`
+}
+
+// AssociationLabelInput represents the request body for creating or updating
+// a custom association label. Name is only used on create and
+// AssociationTypeID only on update.
+type AssociationLabelInput struct {
+	Label             string `json:"label"`
+	Name              string `json:"name,omitempty"`
+	InverseLabel      string `json:"inverseLabel,omitempty"`
+	AssociationTypeID int    `json:"associationTypeId,omitempty"`
+}
+
+// associationObjectID identifies a record in a batch associations request
+type associationObjectID struct {
+	ID string `json:"id"`
+}
+
+// associationLabelsArchiveInput removes specific association types between two records
+type associationLabelsArchiveInput struct {
+	From  associationObjectID `json:"from"`
+	To    associationObjectID `json:"to"`
+	Types []AssociationSpec   `json:"types"`
+}
+
+// associationsPath builds the associations path for a record and a target
+// object type, optionally followed by additional path segments
+func (c *Client) associationsPath(fromObjectType, fromID, toObjectType string, segments ...string) string {
+	return c.routePath(RouteObjectAssociations,
+		append([]string{fromObjectType, fromID, "associations", toObjectType}, segments...)...)
+}
+
+// associationLabelsPath builds the path for the association labels between two object types
+func (c *Client) associationLabelsPath(fromObjectType, toObjectType string, segments ...string) string {
+	return c.routePath(RouteAssociations, append([]string{fromObjectType, toObjectType, "labels"}, segments...)...)
+}
+
+// ListAssociations returns all records of toObjectType associated with the given record
+func (c *Client) ListAssociations(ctx context.Context, fromObjectType, fromID, toObjectType string) ([]AssociatedObject, error) {
+	var associated []AssociatedObject
+	after := ""
+
+	for {
+		query := url.Values{}
+		query.Set("limit", "500")
+		if after != "" {
+			query.Set("after", after)
+		}
+
+		resp, err := c.Get(ctx, c.associationsPath(fromObjectType, fromID, toObjectType)+"?"+query.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s associations: %w", toObjectType, err)
+		}
+
+		var page associationListResponse
+		if err := DecodeResponse(resp, &page); err != nil {
+			return nil, fmt.Errorf("failed to decode associations response: %w", err)
+		}
+
+		associated = append(associated, page.Results...)
+
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return associated, nil
+		}
+		after = page.Paging.Next.After
+	}
+}
+
+// CreateDefaultAssociation associates two records using the default (unlabeled) association type
+func (c *Client) CreateDefaultAssociation(ctx context.Context, fromObjectType, fromID, toObjectType, toID string) error {
+	path := c.routePath(RouteObjectAssociations, fromObjectType, fromID, "associations", "default", toObjectType, toID)
+
+	resp, err := c.Put(ctx, path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to associate %s %s with %s %s: %w", fromObjectType, fromID, toObjectType, toID, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// DeleteAssociations removes all associations between two records
+func (c *Client) DeleteAssociations(ctx context.Context, fromObjectType, fromID, toObjectType, toID string) error {
+	path := c.associationsPath(fromObjectType, fromID, toObjectType, toID)
+
+	resp, err := c.Delete(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to remove associations between %s %s and %s %s: %w", fromObjectType, fromID, toObjectType, toID, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// CreateAssociation associates two records with the given association types
+func (c *Client) CreateAssociation(ctx context.Context, fromObjectType, fromID, toObjectType, toID string, specs []AssociationSpec) error {
+	path := c.associationsPath(fromObjectType, fromID, toObjectType, toID)
+
+	resp, err := c.Put(ctx, path, specs)
+	if err != nil {
+		return fmt.Errorf("failed to associate %s %s with %s %s: %w", fromObjectType, fromID, toObjectType, toID, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// DeleteAssociation removes a single association type between two records,
+// leaving any other associations between them in place
+func (c *Client) DeleteAssociation(ctx context.Context, fromObjectType, fromID, toObjectType, toID string, spec AssociationSpec) error {
+	path := c.routePath(RouteAssociations, fromObjectType, toObjectType, "batch", "labels", "archive")
+
+	reqBody := struct {
+		Inputs []associationLabelsArchiveInput `json:"inputs"`
+	}{
+		Inputs: []associationLabelsArchiveInput{
+			{
+				From:  associationObjectID{ID: fromID},
+				To:    associationObjectID{ID: toID},
+				Types: []AssociationSpec{spec},
+			},
+		},
+	}
+
+	resp, err := c.Post(ctx, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to remove association between %s %s and %s %s: %w", fromObjectType, fromID, toObjectType, toID, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListAssociationLabels returns the association types defined from one object type to another
+func (c *Client) ListAssociationLabels(ctx context.Context, fromObjectType, toObjectType string) ([]AssociationType, error) {
+	resp, err := c.Get(ctx, c.associationLabelsPath(fromObjectType, toObjectType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s to %s association labels: %w", fromObjectType, toObjectType, err)
+	}
+
+	var labels associationLabelsResponse
+	if err := DecodeResponse(resp, &labels); err != nil {
+		return nil, fmt.Errorf("failed to decode association labels response: %w", err)
+	}
+
+	return labels.Results, nil
+}
+
+// CreateAssociationLabel defines a custom association label between two
+// object types. The result contains the generated association type, plus the
+// inverse association type when an inverse label is given.
+func (c *Client) CreateAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, input *AssociationLabelInput) ([]AssociationType, error) {
+	reqBody := AssociationLabelInput{
+		Label:        input.Label,
+		Name:         input.Name,
+		InverseLabel: input.InverseLabel,
+	}
+
+	resp, err := c.Post(ctx, c.associationLabelsPath(fromObjectType, toObjectType), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s to %s association label: %w", fromObjectType, toObjectType, err)
+	}
+
+	var created associationLabelsResponse
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode association label response: %w", err)
+	}
+
+	return created.Results, nil
+}
+
+// UpdateAssociationLabel changes the label and inverse label of a custom association type
+func (c *Client) UpdateAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, input *AssociationLabelInput) error {
+	reqBody := AssociationLabelInput{
+		Label:             input.Label,
+		InverseLabel:      input.InverseLabel,
+		AssociationTypeID: input.AssociationTypeID,
+	}
+
+	resp, err := c.Put(ctx, c.associationLabelsPath(fromObjectType, toObjectType), reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to update %s to %s association label: %w", fromObjectType, toObjectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// DeleteAssociationLabel deletes a custom association type between two object types
+func (c *Client) DeleteAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, associationTypeID int) error {
+	resp, err := c.Delete(ctx, c.associationLabelsPath(fromObjectType, toObjectType, strconv.Itoa(associationTypeID)))
+	if err != nil {
+		return fmt.Errorf("failed to delete %s to %s association label: %w", fromObjectType, toObjectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenRefreshMargin is how long before its expiry an OAuth access token is
+// refreshed, so a token never expires while a request is in flight. Tokens
+// that live shorter than twice the margin are refreshed halfway through
+// their lifetime.
+const TokenRefreshMargin = 5 * time.Minute
+
+// TokenSource supplies the bearer token sent with every request
+type TokenSource interface {
+	// Token returns the token to authenticate the next request with
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards token after HubSpot rejected it. It returns true
+	// when the next call to Token may return a different token, so the
+	// rejected request is worth retrying.
+	Invalidate(token string) bool
+}
+
+// StaticTokenSource is a TokenSource for a fixed private app token
+type StaticTokenSource string
+
+// Token returns the static token
+func (s StaticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// Invalidate does nothing, since a static token cannot be replaced
+func (s StaticTokenSource) Invalidate(token string) bool {
+	return false
+}
+
+// OAuthConfig holds the credentials of a HubSpot public app installation
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	// TokenURL is the token endpoint. Defaults to /oauth/v1/token on the
+	// base URL of the client.
+	TokenURL string
+}
+
+// oauthTokenResponse is the response of the OAuth token endpoint
+type oauthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+}
+
+// OAuthTokenSource is a TokenSource that exchanges a refresh token for
+// access tokens, refreshing them shortly before they expire
+type OAuthTokenSource struct {
+	config     OAuthConfig
+	httpClient *http.Client
+
+	mu           sync.Mutex
+	refreshToken string
+	accessToken  string
+	// refreshAt is zero when the token endpoint did not say when the
+	// access token expires, in which case it is used until rejected
+	refreshAt time.Time
+}
+
+// NewOAuthTokenSource creates a TokenSource for the given OAuth credentials
+func NewOAuthTokenSource(config OAuthConfig, httpClient *http.Client) *OAuthTokenSource {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OAuthTokenSource{
+		config:       config,
+		httpClient:   httpClient,
+		refreshToken: config.RefreshToken,
+	}
+}
+
+// Token returns the current access token, refreshing it first when it is
+// missing or about to expire
+func (s *OAuthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && (s.refreshAt.IsZero() || time.Now().Before(s.refreshAt)) {
+		return s.accessToken, nil
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+	return s.accessToken, nil
+}
+
+// Invalidate discards the access token so the next call to Token refreshes
+// it. A token that was already replaced by another request is left alone.
+func (s *OAuthTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken == token {
+		s.accessToken = ""
+	}
+	return true
+}
+
+// refresh exchanges the refresh token for a new access token. The caller
+// must hold s.mu.
+func (s *OAuthTokenSource) refresh(ctx context.Context) error {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {s.config.ClientID},
+		"client_secret": {s.config.ClientSecret},
+		"refresh_token": {s.refreshToken},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to refresh access token: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return fmt.Errorf("failed to refresh access token: %w", parseErrorResponse(resp))
+	}
+
+	var token oauthTokenResponse
+	if err := DecodeResponse(resp, &token); err != nil {
+		return fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return errors.New("failed to refresh access token: token endpoint returned no access token")
+	}
+
+	s.accessToken = token.AccessToken
+	s.refreshAt = time.Time{}
+	if token.ExpiresIn > 0 {
+		lifetime := time.Duration(token.ExpiresIn) * time.Second
+		margin := TokenRefreshMargin
+		if lifetime < 2*margin {
+			margin = lifetime / 2
+		}
+		s.refreshAt = time.Now().Add(lifetime - margin)
+	}
+	// HubSpot may rotate the refresh token
+	if token.RefreshToken != "" {
+		s.refreshToken = token.RefreshToken
+	}
+
+	return nil
+}
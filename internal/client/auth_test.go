@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// tokenEndpoint is a stand-in for the HubSpot OAuth token endpoint. Every
+// refresh issues access token at-N and rotates the refresh token to rt-N.
+type tokenEndpoint struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	expiresIn     int
+	refreshTokens []string
+}
+
+func newTokenEndpoint(t *testing.T, expiresIn int) *tokenEndpoint {
+	t.Helper()
+
+	e := &tokenEndpoint{expiresIn: expiresIn}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got method %s, want POST", r.Method)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("got Content-Type %q, want application/x-www-form-urlencoded", got)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing token request: %v", err)
+		}
+		for field, want := range map[string]string{
+			"grant_type":    "refresh_token",
+			"client_id":     "client-id",
+			"client_secret": "client-secret",
+		} {
+			if got := r.PostForm.Get(field); got != want {
+				t.Errorf("got %s %q, want %q", field, got, want)
+			}
+		}
+
+		e.mu.Lock()
+		e.refreshTokens = append(e.refreshTokens, r.PostForm.Get("refresh_token"))
+		n := len(e.refreshTokens)
+		e.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"at-%d","refresh_token":"rt-%d","expires_in":%d,"token_type":"bearer"}`, n, n, e.expiresIn)
+	}))
+	t.Cleanup(e.Close)
+
+	return e
+}
+
+func (e *tokenEndpoint) refreshes() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.refreshTokens...)
+}
+
+func newTestTokenSource(e *tokenEndpoint) *OAuthTokenSource {
+	return NewOAuthTokenSource(OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RefreshToken: "rt-0",
+		TokenURL:     e.URL,
+	}, nil)
+}
+
+func mustToken(t *testing.T, s *OAuthTokenSource) string {
+	t.Helper()
+
+	token, err := s.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return token
+}
+
+func TestOAuthTokenSourceCachesToken(t *testing.T) {
+	e := newTokenEndpoint(t, 1800)
+	s := newTestTokenSource(e)
+
+	start := time.Now()
+	if got := mustToken(t, s); got != "at-1" {
+		t.Errorf("got token %q, want at-1", got)
+	}
+	if got := mustToken(t, s); got != "at-1" {
+		t.Errorf("got token %q, want at-1", got)
+	}
+
+	if got := e.refreshes(); len(got) != 1 {
+		t.Errorf("got %d refreshes, want 1", len(got))
+	}
+
+	// The token is refreshed TokenRefreshMargin before it expires
+	want := start.Add(1800*time.Second - TokenRefreshMargin)
+	if s.refreshAt.Before(want) || s.refreshAt.After(want.Add(time.Second)) {
+		t.Errorf("got refreshAt %v, want about %v", s.refreshAt, want)
+	}
+}
+
+func TestOAuthTokenSourceRefreshesShortLivedToken(t *testing.T) {
+	e := newTokenEndpoint(t, 1)
+	s := newTestTokenSource(e)
+
+	start := time.Now()
+	if got := mustToken(t, s); got != "at-1" {
+		t.Errorf("got token %q, want at-1", got)
+	}
+
+	// A token living shorter than twice the margin is refreshed halfway
+	// through its lifetime
+	want := start.Add(500 * time.Millisecond)
+	if s.refreshAt.Before(want) || s.refreshAt.After(want.Add(100*time.Millisecond)) {
+		t.Errorf("got refreshAt %v, want about %v", s.refreshAt, want)
+	}
+
+	time.Sleep(600 * time.Millisecond)
+
+	if got := mustToken(t, s); got != "at-2" {
+		t.Errorf("got token %q, want at-2", got)
+	}
+	if got := e.refreshes(); len(got) != 2 {
+		t.Errorf("got %d refreshes, want 2", len(got))
+	}
+}
+
+func TestOAuthTokenSourceUsesRotatedRefreshToken(t *testing.T) {
+	e := newTokenEndpoint(t, 1800)
+	s := newTestTokenSource(e)
+
+	mustToken(t, s)
+	s.Invalidate("at-1")
+	mustToken(t, s)
+
+	got := e.refreshes()
+	if len(got) != 2 || got[0] != "rt-0" || got[1] != "rt-1" {
+		t.Errorf("got refresh tokens %v, want [rt-0 rt-1]", got)
+	}
+}
+
+func TestOAuthTokenSourceWithoutExpiry(t *testing.T) {
+	e := newTokenEndpoint(t, 0)
+	s := newTestTokenSource(e)
+
+	mustToken(t, s)
+	mustToken(t, s)
+
+	if got := e.refreshes(); len(got) != 1 {
+		t.Errorf("got %d refreshes, want 1", len(got))
+	}
+}
+
+func TestOAuthTokenSourceRefreshesOnUnauthorized(t *testing.T) {
+	e := newTokenEndpoint(t, 1800)
+
+	var mu sync.Mutex
+	var authorizations []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") == "Bearer at-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"status":"error","message":"expired","category":"EXPIRED_AUTHENTICATION"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1","properties":{}}`)
+	}))
+	defer api.Close()
+
+	c := NewClient(Config{
+		BaseURL:         api.URL,
+		BatchReadWindow: -1,
+		OAuth: &OAuthConfig{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RefreshToken: "rt-0",
+			TokenURL:     e.URL,
+		},
+	})
+
+	if _, err := c.GetContact(context.Background(), "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := e.refreshes(); len(got) != 2 {
+		t.Errorf("got %d refreshes, want 2 (initial and after the 401)", len(got))
+	}
+	if len(authorizations) != 2 || authorizations[0] != "Bearer at-1" || authorizations[1] != "Bearer at-2" {
+		t.Errorf("got authorizations %v, want [Bearer at-1 Bearer at-2]", authorizations)
+	}
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// BatchSize is the largest number of inputs HubSpot accepts per batch request
+const BatchSize = 100
+
+// BatchObjectInput represents a single input of a batch update or upsert.
+// Upserts identify the object by the value of IDProperty instead of its record ID.
+type BatchObjectInput struct {
+	ID         string                 `json:"id"`
+	IDProperty string                 `json:"idProperty,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// BatchError represents the failure of one or more inputs of a batch request
+type BatchError struct {
+	Status      string                 `json:"status"`
+	Category    string                 `json:"category"`
+	SubCategory string                 `json:"subCategory,omitempty"`
+	Message     string                 `json:"message"`
+	Context     map[string]interface{} `json:"context,omitempty"`
+}
+
+// IDs returns the IDs of the inputs the error applies to, when HubSpot reports them
+func (e BatchError) IDs() []string {
+	values, _ := e.Context["ids"].([]interface{})
+
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Err converts the batch error into a HubSpotError, so callers can check it
+// like the error of a single request
+func (e BatchError) Err() *HubSpotError {
+	statusCode := http.StatusBadRequest
+	switch e.Category {
+	case CategoryObjectNotFound:
+		statusCode = http.StatusNotFound
+	case CategoryConflict:
+		statusCode = http.StatusConflict
+	}
+
+	return &HubSpotError{
+		Status:      e.Status,
+		Message:     e.Message,
+		Category:    e.Category,
+		SubCategory: e.SubCategory,
+		Context:     e.Context,
+		StatusCode:  statusCode,
+	}
+}
+
+// BatchResult holds the combined outcome of all chunks of a batch operation.
+// HubSpot answers partially failed batches with 207 Multi-Status; the
+// successful inputs are in Results and the failed ones in Errors.
+type BatchResult struct {
+	Results []CRMObject
+	Errors  []BatchError
+}
+
+// batchResponse represents the response of a single batch request
+type batchResponse struct {
+	Status    string       `json:"status"`
+	Results   []CRMObject  `json:"results"`
+	Errors    []BatchError `json:"errors,omitempty"`
+	NumErrors int          `json:"numErrors,omitempty"`
+}
+
+// batchReadRequest represents the request body of a batch read
+type batchReadRequest struct {
+	Properties []string       `json:"properties"`
+	IDProperty string         `json:"idProperty,omitempty"`
+	Inputs     []batchInputID `json:"inputs"`
+}
+
+// batchInputID identifies a single object of a batch read or archive
+type batchInputID struct {
+	ID string `json:"id"`
+}
+
+// batchInputs wraps the inputs of a batch request
+type batchInputs[T any] struct {
+	Inputs []T `json:"inputs"`
+}
+
+// chunk splits items into slices of at most size items
+func chunk[T any](items []T, size int) [][]T {
+	var chunks [][]T
+	for len(items) > size {
+		chunks = append(chunks, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
+
+// batchInputIDs wraps record IDs as batch inputs
+func batchInputIDs(ids []string) []batchInputID {
+	inputs := make([]batchInputID, 0, len(ids))
+	for _, id := range ids {
+		inputs = append(inputs, batchInputID{ID: id})
+	}
+	return inputs
+}
+
+// doBatch sends the inputs in chunks of BatchSize to the given batch
+// endpoint and combines the results. On failure it returns the results of
+// the chunks that completed along with the error.
+func doBatch[T any](ctx context.Context, c *Client, objectType, action string, inputs []T, body func([]T) interface{}) (*BatchResult, error) {
+	result := &BatchResult{}
+
+	for i, inputs := range chunk(inputs, BatchSize) {
+		resp, err := c.Post(ctx, c.objectsPath(objectType, "batch", action), body(inputs))
+		if err != nil {
+			return result, fmt.Errorf("failed to batch %s %s objects (chunk %d): %w", action, objectType, i+1, err)
+		}
+
+		var batchResp batchResponse
+		if err := DecodeResponse(resp, &batchResp); err != nil {
+			return result, fmt.Errorf("failed to decode batch %s response: %w", action, err)
+		}
+
+		result.Results = append(result.Results, batchResp.Results...)
+		result.Errors = append(result.Errors, batchResp.Errors...)
+	}
+
+	return result, nil
+}
+
+// BatchReadObjects reads CRM objects by record ID, or by the value of
+// opts.IDProperty when set
+func (c *Client) BatchReadObjects(ctx context.Context, objectType string, ids []string, opts *GetObjectOptions) (*BatchResult, error) {
+	if opts == nil {
+		opts = &GetObjectOptions{}
+	}
+
+	return doBatch(ctx, c, objectType, "read", batchInputIDs(ids), func(inputs []batchInputID) interface{} {
+		return batchReadRequest{
+			Properties: nonNilStrings(opts.Properties),
+			IDProperty: opts.IDProperty,
+			Inputs:     inputs,
+		}
+	})
+}
+
+// BatchCreateObjects creates CRM objects. The results are not guaranteed to
+// be in the order of the inputs.
+func (c *Client) BatchCreateObjects(ctx context.Context, objectType string, inputs []CRMObjectRequest) (*BatchResult, error) {
+	return doBatch(ctx, c, objectType, "create", inputs, func(inputs []CRMObjectRequest) interface{} {
+		return batchInputs[CRMObjectRequest]{Inputs: inputs}
+	})
+}
+
+// BatchUpdateObjects updates CRM objects by record ID
+func (c *Client) BatchUpdateObjects(ctx context.Context, objectType string, inputs []BatchObjectInput) (*BatchResult, error) {
+	return doBatch(ctx, c, objectType, "update", inputs, func(inputs []BatchObjectInput) interface{} {
+		return batchInputs[BatchObjectInput]{Inputs: inputs}
+	})
+}
+
+// BatchUpsertObjects creates or updates CRM objects identified by the value
+// of a unique property. Each input must set IDProperty.
+func (c *Client) BatchUpsertObjects(ctx context.Context, objectType string, inputs []BatchObjectInput) (*BatchResult, error) {
+	return doBatch(ctx, c, objectType, "upsert", inputs, func(inputs []BatchObjectInput) interface{} {
+		return batchInputs[BatchObjectInput]{Inputs: inputs}
+	})
+}
+
+// BatchArchiveObjects archives CRM objects by record ID
+func (c *Client) BatchArchiveObjects(ctx context.Context, objectType string, ids []string) error {
+	for i, inputs := range chunk(batchInputIDs(ids), BatchSize) {
+		resp, err := c.Post(ctx, c.objectsPath(objectType, "batch", "archive"), batchInputs[batchInputID]{Inputs: inputs})
+		if err != nil {
+			return fmt.Errorf("failed to batch archive %s objects (chunk %d): %w", objectType, i+1, err)
+		}
+		resp.Body.Close()
+	}
+
+	return nil
+}
+
+// nonNilStrings returns values, or an empty slice when values is nil
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
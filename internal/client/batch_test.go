@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		items int
+		want  []int
+	}{
+		{items: 0, want: nil},
+		{items: 1, want: []int{1}},
+		{items: 100, want: []int{100}},
+		{items: 101, want: []int{100, 1}},
+		{items: 250, want: []int{100, 100, 50}},
+	}
+
+	for _, tt := range tests {
+		items := make([]int, tt.items)
+		for i := range items {
+			items[i] = i
+		}
+
+		chunks := chunk(items, BatchSize)
+
+		var sizes []int
+		next := 0
+		for _, c := range chunks {
+			sizes = append(sizes, len(c))
+			for _, item := range c {
+				if item != next {
+					t.Errorf("chunk(%d): got item %d, want %d", tt.items, item, next)
+				}
+				next++
+			}
+		}
+		if fmt.Sprint(sizes) != fmt.Sprint(tt.want) {
+			t.Errorf("chunk(%d): got sizes %v, want %v", tt.items, sizes, tt.want)
+		}
+	}
+}
+
+// batchCreateServer answers batch creates with one result per input. The
+// inputs with a "fail" property are reported as errors with a 207 status.
+func batchCreateServer(t *testing.T, sizes *[]int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/crm/v3/objects/contacts/batch/create" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var req batchInputs[CRMObjectRequest]
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding batch request: %v", err)
+		}
+		*sizes = append(*sizes, len(req.Inputs))
+
+		var results, errs []string
+		for _, input := range req.Inputs {
+			email, _ := input.Properties["email"].(string)
+			switch input.Properties["fail"] {
+			case "conflict":
+				errs = append(errs, fmt.Sprintf(`{"status":"error","category":"CONFLICT","message":"%s already exists"}`, email))
+			case "missing":
+				errs = append(errs, fmt.Sprintf(`{"status":"error","category":"OBJECT_NOT_FOUND","message":"%s not found","context":{"ids":["%s"]}}`, email, email))
+			default:
+				results = append(results, fmt.Sprintf(`{"id":%q,"properties":{"email":%q}}`, email, email))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(errs) > 0 {
+			w.WriteHeader(http.StatusMultiStatus)
+		}
+		fmt.Fprintf(w, `{"status":"COMPLETE","results":[%s],"errors":[%s],"numErrors":%d}`,
+			strings.Join(results, ","), strings.Join(errs, ","), len(errs))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestBatchCreateObjectsCombinesChunks(t *testing.T) {
+	var sizes []int
+	server := batchCreateServer(t, &sizes)
+	c := newTestClient(server.URL, nil)
+
+	inputs := make([]CRMObjectRequest, 250)
+	for i := range inputs {
+		inputs[i] = CRMObjectRequest{Properties: map[string]interface{}{"email": fmt.Sprintf("c%d@example.com", i)}}
+	}
+
+	result, err := c.BatchCreateObjects(context.Background(), ObjectTypeContacts, inputs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(sizes) != "[100 100 50]" {
+		t.Errorf("got chunk sizes %v, want [100 100 50]", sizes)
+	}
+	if len(result.Results) != 250 || len(result.Errors) != 0 {
+		t.Fatalf("got %d results and %d errors, want 250 results", len(result.Results), len(result.Errors))
+	}
+	if result.Results[0].ID != "c0@example.com" || result.Results[249].ID != "c249@example.com" {
+		t.Errorf("got results from %s to %s, want c0 to c249", result.Results[0].ID, result.Results[249].ID)
+	}
+}
+
+func TestBatchCreateObjectsPartialFailure(t *testing.T) {
+	var sizes []int
+	server := batchCreateServer(t, &sizes)
+	c := newTestClient(server.URL, nil)
+
+	inputs := make([]CRMObjectRequest, 150)
+	for i := range inputs {
+		inputs[i] = CRMObjectRequest{Properties: map[string]interface{}{"email": fmt.Sprintf("c%d@example.com", i)}}
+	}
+	inputs[10].Properties["fail"] = "conflict"
+	inputs[120].Properties["fail"] = "missing"
+
+	result, err := c.BatchCreateObjects(context.Background(), ObjectTypeContacts, inputs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Results) != 148 {
+		t.Errorf("got %d results, want 148", len(result.Results))
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("got %d errors, want 2", len(result.Errors))
+	}
+
+	conflict := result.Errors[0].Err()
+	if !errors.Is(conflict, ErrConflict) || conflict.StatusCode != http.StatusConflict {
+		t.Errorf("got %v (HTTP %d), want a conflict", conflict, conflict.StatusCode)
+	}
+
+	missing := result.Errors[1].Err()
+	if !IsNotFound(missing) || missing.StatusCode != http.StatusNotFound {
+		t.Errorf("got %v (HTTP %d), want not found", missing, missing.StatusCode)
+	}
+	if ids := result.Errors[1].IDs(); len(ids) != 1 || ids[0] != "c120@example.com" {
+		t.Errorf("got IDs %v, want [c120@example.com]", ids)
+	}
+}
+
+func TestBatchFailedChunkKeepsEarlierResults(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests > 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status":"error","message":"invalid input","category":"VALIDATION_ERROR"}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[{"id":"1","properties":{}}]}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, nil)
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprint(i)
+	}
+
+	result, err := c.BatchReadObjects(context.Background(), ObjectTypeContacts, ids, nil)
+	if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), "chunk 2") {
+		t.Errorf("got error %v, want a validation error of chunk 2", err)
+	}
+	if result == nil || len(result.Results) != 1 {
+		t.Errorf("got result %+v, want the result of the first chunk", result)
+	}
+}
@@ -7,16 +7,33 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
 // Client represents a HubSpot API client
 type Client struct {
-	apiToken    string
-	baseURL     string
-	apiVersion  string
+	tokens     TokenSource
+	baseURL    string
+	apiVersion string
+	// versionErr is returned by every request if the client does not
+	// implement apiVersion
+	versionErr  error
 	httpClient  *http.Client
 	retryConfig RetryConfig
+	rateLimiter *RateLimiter
+	reads       *readCoalescer
+
+	// tokenInfo is recorded by ValidateCredentials
+	infoMu    sync.Mutex
+	tokenInfo *TokenInfo
+
+	// portalID is fetched by PortalID on first use
+	portalMu sync.Mutex
+	portalID int64
+	// expectedPortalID is the portal the client must be authenticated with,
+	// or zero if any portal will do
+	expectedPortalID int64
 }
 
 // Config holds the configuration for creating a new Client
@@ -25,6 +42,21 @@ type Config struct {
 	BaseURL    string
 	APIVersion string
 	Timeout    time.Duration
+	// BatchReadWindow is how long single-object reads wait to be combined
+	// into a batch read. Defaults to DefaultBatchReadWindow; a negative
+	// value disables coalescing.
+	BatchReadWindow time.Duration
+	// RateLimit caps the request rate of the client
+	RateLimit RateLimitConfig
+	// OAuth authenticates with the access tokens of a public app
+	// installation instead of APIToken
+	OAuth *OAuthConfig
+	// TokenSource supplies the token instead of APIToken, e.g. a
+	// CredentialTokenSource
+	TokenSource TokenSource
+	// ExpectedPortalID is the portal the client must be authenticated with.
+	// Zero accepts any portal.
+	ExpectedPortalID int64
 }
 
 // NewClient creates a new HubSpot API client
@@ -34,21 +66,42 @@ func NewClient(config Config) *Client {
 		config.BaseURL = "https://api.hubapi.com"
 	}
 	if config.APIVersion == "" {
-		config.APIVersion = "v3"
+		config.APIVersion = DefaultAPIVersion
 	}
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
+	if config.BatchReadWindow == 0 {
+		config.BatchReadWindow = DefaultBatchReadWindow
+	}
 
-	return &Client{
-		apiToken:   config.APIToken,
-		baseURL:    config.BaseURL,
-		apiVersion: config.APIVersion,
+	c := &Client{
+		tokens:           StaticTokenSource(config.APIToken),
+		baseURL:          config.BaseURL,
+		apiVersion:       config.APIVersion,
+		versionErr:       ValidateAPIVersion(config.APIVersion),
+		expectedPortalID: config.ExpectedPortalID,
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
 		retryConfig: DefaultRetryConfig(),
+		rateLimiter: NewRateLimiter(config.RateLimit),
+	}
+	if config.OAuth != nil {
+		oauth := *config.OAuth
+		if oauth.TokenURL == "" {
+			oauth.TokenURL = c.buildURL(c.routePath(RouteOAuth, "token"))
+		}
+		c.tokens = NewOAuthTokenSource(oauth, c.httpClient)
 	}
+	if config.TokenSource != nil {
+		c.tokens = config.TokenSource
+	}
+	if config.BatchReadWindow > 0 {
+		c.reads = newReadCoalescer(c, config.BatchReadWindow)
+	}
+
+	return c
 }
 
 // SetRetryConfig allows customizing the retry configuration
@@ -61,20 +114,31 @@ func (c *Client) buildURL(path string) string {
 	return fmt.Sprintf("%s/%s", c.baseURL, path)
 }
 
-// addAuthHeader adds the authentication header to the request
-func (c *Client) addAuthHeader(req *http.Request) {
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+// addAuthHeader adds the authentication header to the request and returns
+// the token it used
+func (c *Client) addAuthHeader(ctx context.Context, req *http.Request) (string, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return token, nil
 }
 
-// addCommonHeaders adds common headers to all requests
+// addCommonHeaders adds common headers to all requests. The authentication
+// header is added to each attempt by doWithRetry, since the token may be
+// refreshed between attempts.
 func (c *Client) addCommonHeaders(req *http.Request) {
-	c.addAuthHeader(req)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 }
 
 // doRequest executes an HTTP request with retry logic and error handling
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	if c.versionErr != nil {
+		return nil, c.versionErr
+	}
+
 	url := c.buildURL(path)
 
 	var bodyReader io.Reader
@@ -122,6 +186,11 @@ func (c *Client) Patch(ctx context.Context, path string, body interface{}) (*htt
 	return c.doRequest(ctx, http.MethodPatch, path, body)
 }
 
+// Put performs a PUT request
+func (c *Client) Put(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodPut, path, body)
+}
+
 // Delete performs a DELETE request
 func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error) {
 	return c.doRequest(ctx, http.MethodDelete, path, nil)
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBatchReadWindow is how long single-object reads wait to be
+// combined with concurrent reads of the same object type into a batch read
+const DefaultBatchReadWindow = 10 * time.Millisecond
+
+// readCoalescer combines concurrent single-object reads into batch reads.
+// Reads are grouped by object type and requested properties; a group is
+// sent once its window has passed or it reaches BatchSize objects.
+type readCoalescer struct {
+	client *Client
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[readKey]*readBatch
+}
+
+// readKey identifies reads that can share a batch read
+type readKey struct {
+	objectType string
+	properties string
+}
+
+// readBatch collects the reads of one batch read
+type readBatch struct {
+	key        readKey
+	properties []string
+	waiters    map[string][]chan readResult
+	ids        []string
+	timer      *time.Timer
+}
+
+// readResult is the outcome of a single coalesced read
+type readResult struct {
+	object *CRMObject
+	err    error
+}
+
+// newReadCoalescer creates a read coalescer for the client
+func newReadCoalescer(c *Client, window time.Duration) *readCoalescer {
+	return &readCoalescer{
+		client:  c,
+		window:  window,
+		pending: make(map[readKey]*readBatch),
+	}
+}
+
+// get reads a single object by record ID as part of a batch read
+func (rc *readCoalescer) get(ctx context.Context, objectType, id string, properties []string) (*CRMObject, error) {
+	sorted := append([]string(nil), properties...)
+	sort.Strings(sorted)
+	key := readKey{objectType: objectType, properties: strings.Join(sorted, ",")}
+
+	ch := make(chan readResult, 1)
+
+	rc.mu.Lock()
+	batch, ok := rc.pending[key]
+	if !ok {
+		batch = &readBatch{
+			key:        key,
+			properties: sorted,
+			waiters:    make(map[string][]chan readResult),
+		}
+		rc.pending[key] = batch
+		batch.timer = time.AfterFunc(rc.window, func() { rc.flush(batch) })
+	}
+	if _, ok := batch.waiters[id]; !ok {
+		batch.ids = append(batch.ids, id)
+	}
+	batch.waiters[id] = append(batch.waiters[id], ch)
+	full := len(batch.ids) >= BatchSize
+	rc.mu.Unlock()
+
+	if full {
+		go rc.flush(batch)
+	}
+
+	select {
+	case result := <-ch:
+		return result.object, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends the batch read and hands each waiter its result. It is called
+// by the batch timer and when the batch is full; only the first call sends.
+func (rc *readCoalescer) flush(batch *readBatch) {
+	rc.mu.Lock()
+	if rc.pending[batch.key] != batch {
+		rc.mu.Unlock()
+		return
+	}
+	delete(rc.pending, batch.key)
+	batch.timer.Stop()
+	rc.mu.Unlock()
+
+	// The batch is shared by several callers, so no single caller's context applies
+	result, err := rc.client.BatchReadObjects(context.Background(), batch.key.objectType, batch.ids, &GetObjectOptions{
+		Properties: batch.properties,
+	})
+
+	if err != nil && !IsNotFound(err) {
+		// A batch error may be caused by a single ID, e.g. a malformed one, so
+		// it is only handed out as is when the batch has no other IDs
+		if len(batch.ids) > 1 {
+			rc.readEach(batch)
+			return
+		}
+		for _, waiters := range batch.waiters {
+			for _, ch := range waiters {
+				ch <- readResult{err: err}
+			}
+		}
+		return
+	}
+
+	objects := make(map[string]*CRMObject)
+	if result != nil {
+		for i := range result.Results {
+			objects[result.Results[i].ID] = &result.Results[i]
+		}
+	}
+
+	for id, waiters := range batch.waiters {
+		res := readResult{object: objects[id]}
+		if res.object == nil {
+			res.err = &HubSpotError{
+				Status:     "404",
+				Message:    fmt.Sprintf("%s object %s not found", batch.key.objectType, id),
+				Category:   CategoryObjectNotFound,
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		for _, ch := range waiters {
+			ch <- res
+		}
+	}
+}
+
+// readEach reads the objects of a failed batch read one by one, so each
+// waiter gets the result of its own ID
+func (rc *readCoalescer) readEach(batch *readBatch) {
+	var wg sync.WaitGroup
+	for id, waiters := range batch.waiters {
+		wg.Add(1)
+		go func(id string, waiters []chan readResult) {
+			defer wg.Done()
+
+			object, err := rc.client.getObject(context.Background(), batch.key.objectType, id, &GetObjectOptions{
+				Properties: batch.properties,
+			})
+			for _, ch := range waiters {
+				ch <- readResult{object: object, err: err}
+			}
+		}(id, waiters)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// objectsServer serves batch and single reads of contacts. Batch reads fail
+// with batchStatus when it is set; otherwise they return the known IDs.
+// Single reads of known IDs succeed and all others fail with 400.
+type objectsServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	batchStatus int
+	known       map[string]bool
+	batchReads  [][]string
+	singleReads []string
+}
+
+func newObjectsServer(t *testing.T, batchStatus int, known ...string) *objectsServer {
+	t.Helper()
+
+	s := &objectsServer{batchStatus: batchStatus, known: map[string]bool{}}
+	for _, id := range known {
+		s.known[id] = true
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/crm/v3/objects/contacts/batch/read" {
+			var req batchReadRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("decoding batch read: %v", err)
+			}
+
+			var ids []string
+			var results []string
+			for _, input := range req.Inputs {
+				ids = append(ids, input.ID)
+				if s.known[input.ID] {
+					results = append(results, fmt.Sprintf(`{"id":%q,"properties":{}}`, input.ID))
+				}
+			}
+
+			s.mu.Lock()
+			s.batchReads = append(s.batchReads, ids)
+			s.mu.Unlock()
+
+			if s.batchStatus != 0 {
+				w.WriteHeader(s.batchStatus)
+				fmt.Fprint(w, `{"status":"error","message":"invalid input","category":"VALIDATION_ERROR"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"status":"COMPLETE","results":[%s]}`, strings.Join(results, ","))
+			return
+		}
+
+		if id, ok := strings.CutPrefix(r.URL.Path, "/crm/v3/objects/contacts/"); ok && r.Method == http.MethodGet {
+			s.mu.Lock()
+			s.singleReads = append(s.singleReads, id)
+			s.mu.Unlock()
+
+			if !s.known[id] {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"status":"error","message":"invalid id","category":"VALIDATION_ERROR"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"id":%q,"properties":{}}`, id)
+			return
+		}
+
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// getConcurrently reads the contacts with the given IDs concurrently, so
+// they share a batch read
+func getConcurrently(c *Client, ids ...string) ([]*CRMObject, []error) {
+	objects := make([]*CRMObject, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			objects[i], errs[i] = c.GetObject(context.Background(), ObjectTypeContacts, id, nil)
+		}(i, id)
+	}
+	wg.Wait()
+
+	return objects, errs
+}
+
+func newCoalescingClient(baseURL string) *Client {
+	return NewClient(Config{
+		APIToken:        "pat-test-token",
+		BaseURL:         baseURL,
+		BatchReadWindow: 50 * time.Millisecond,
+	})
+}
+
+func TestReadCoalescerFansOutBatchResults(t *testing.T) {
+	s := newObjectsServer(t, 0, "1", "2")
+	c := newCoalescingClient(s.URL)
+
+	objects, errs := getConcurrently(c, "1", "2", "3")
+
+	if len(s.batchReads) != 1 || len(s.batchReads[0]) != 3 {
+		t.Fatalf("got batch reads %v, want one of 3 IDs", s.batchReads)
+	}
+	for i, id := range []string{"1", "2"} {
+		if errs[i] != nil {
+			t.Errorf("contact %s: unexpected error: %v", id, errs[i])
+		} else if objects[i].ID != id {
+			t.Errorf("got contact %s, want %s", objects[i].ID, id)
+		}
+	}
+	if !IsNotFound(errs[2]) {
+		t.Errorf("contact 3: got error %v, want not found", errs[2])
+	}
+	if len(s.singleReads) != 0 {
+		t.Errorf("got single reads %v, want none", s.singleReads)
+	}
+}
+
+func TestReadCoalescerReadsEachObjectOnBatchError(t *testing.T) {
+	s := newObjectsServer(t, http.StatusBadRequest, "1", "2")
+	c := newCoalescingClient(s.URL)
+
+	objects, errs := getConcurrently(c, "1", "2", "bad")
+
+	if len(s.batchReads) != 1 {
+		t.Fatalf("got batch reads %v, want one", s.batchReads)
+	}
+	if len(s.singleReads) != 3 {
+		t.Errorf("got single reads %v, want one per ID", s.singleReads)
+	}
+	for i, id := range []string{"1", "2"} {
+		if errs[i] != nil {
+			t.Errorf("contact %s: unexpected error: %v", id, errs[i])
+		} else if objects[i].ID != id {
+			t.Errorf("got contact %s, want %s", objects[i].ID, id)
+		}
+	}
+
+	var hubspotErr *HubSpotError
+	if !errors.As(errs[2], &hubspotErr) || hubspotErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("contact bad: got error %v, want a 400", errs[2])
+	}
+}
+
+func TestReadCoalescerSingleIDBatchError(t *testing.T) {
+	s := newObjectsServer(t, http.StatusBadRequest)
+	c := newCoalescingClient(s.URL)
+
+	_, errs := getConcurrently(c, "bad")
+
+	if errs[0] == nil {
+		t.Fatal("expected an error")
+	}
+	if len(s.singleReads) != 0 {
+		t.Errorf("got single reads %v, want none for a batch of one ID", s.singleReads)
+	}
+}
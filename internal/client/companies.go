@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Company represents a HubSpot company
+type Company = CRMObject
+
+// CreateCompany creates a new company in HubSpot
+func (c *Client) CreateCompany(ctx context.Context, properties map[string]interface{}) (*Company, error) {
+	company, err := c.CreateObject(ctx, ObjectTypeCompanies, properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create company: %w", err)
+	}
+
+	return company, nil
+}
+
+// GetCompany retrieves a company by ID. HubSpot only returns its default
+// properties unless the properties to return are given.
+func (c *Client) GetCompany(ctx context.Context, id string, properties ...string) (*Company, error) {
+	var opts *GetObjectOptions
+	if len(properties) > 0 {
+		opts = &GetObjectOptions{Properties: properties}
+	}
+
+	company, err := c.GetObject(ctx, ObjectTypeCompanies, id, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+
+	return company, nil
+}
+
+// UpdateCompany updates an existing company
+func (c *Client) UpdateCompany(ctx context.Context, id string, properties map[string]interface{}) (*Company, error) {
+	company, err := c.UpdateObject(ctx, ObjectTypeCompanies, id, properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update company: %w", err)
+	}
+
+	return company, nil
+}
+
+// DeleteCompany deletes a company by ID
+func (c *Client) DeleteCompany(ctx context.Context, id string) error {
+	if err := c.ArchiveObject(ctx, ObjectTypeCompanies, id); err != nil {
+		return fmt.Errorf("failed to delete company: %w", err)
+	}
+
+	return nil
+}
+
+// GetCompanyByDomain retrieves a company by domain using the search API
+func (c *Client) GetCompanyByDomain(ctx context.Context, domain string) (*Company, error) {
+	company, err := c.findObjectByProperty(ctx, ObjectTypeCompanies, "domain", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search company by domain: %w", err)
+	}
+
+	return company, nil
+}
@@ -0,0 +1,245 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultProfile is the profile read from the credentials file when none is named
+const DefaultProfile = "default"
+
+// Credential is an API token obtained from a CredentialProvider
+type Credential struct {
+	Token string
+	// Expiry is when the token stops being valid. The zero value means the
+	// token is used until HubSpot rejects it.
+	Expiry time.Time
+}
+
+// CredentialProvider obtains the API token from outside the provider
+// configuration
+type CredentialProvider interface {
+	Credential(ctx context.Context) (*Credential, error)
+}
+
+// StaticCredentialProvider provides a fixed API token
+type StaticCredentialProvider string
+
+// Credential returns the fixed token
+func (p StaticCredentialProvider) Credential(ctx context.Context) (*Credential, error) {
+	return &Credential{Token: string(p)}, nil
+}
+
+// FileCredentialProvider reads the API token from a file. Surrounding
+// whitespace, such as a trailing newline, is ignored.
+type FileCredentialProvider struct {
+	Path string
+}
+
+// Credential reads the token from the file
+func (p FileCredentialProvider) Credential(ctx context.Context) (*Credential, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, fmt.Errorf("API token file %s is empty", p.Path)
+	}
+	return &Credential{Token: token}, nil
+}
+
+// commandOutput is the JSON a credential command prints on stdout
+type commandOutput struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// CommandCredentialProvider runs an external command that prints the API
+// token as JSON on stdout, e.g. {"token": "pat-...", "expires_at":
+// "2024-01-01T00:00:00Z"}. expires_at is optional and in RFC 3339 format.
+// The command is run by the system shell.
+type CommandCredentialProvider struct {
+	Command string
+}
+
+// Credential runs the command and parses its output
+func (p CommandCredentialProvider) Credential(ctx context.Context) (*Credential, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", p.Command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("failed to run credential command: %w: %s", err, message)
+		}
+		return nil, fmt.Errorf("failed to run credential command: %w", err)
+	}
+
+	var output commandOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("failed to decode credential command output: %w", err)
+	}
+	if output.Token == "" {
+		return nil, errors.New("credential command output has no token")
+	}
+
+	credential := &Credential{Token: output.Token}
+	if output.ExpiresAt != "" {
+		expiry, err := time.Parse(time.RFC3339, output.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse expires_at of credential command output: %w", err)
+		}
+		credential.Expiry = expiry
+	}
+
+	return credential, nil
+}
+
+// ProfileCredentialProvider reads the API token of a named profile from a
+// credentials file in INI format:
+//
+//	[default]
+//	api_token = pat-na1-...
+//
+//	[sandbox]
+//	api_token = pat-na1-...
+type ProfileCredentialProvider struct {
+	// Path defaults to DefaultCredentialsFile
+	Path string
+	// Profile defaults to DefaultProfile
+	Profile string
+}
+
+// DefaultCredentialsFile returns the path of ~/.hubspot/credentials
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".hubspot", "credentials"), nil
+}
+
+// Credential reads the token of the profile from the credentials file
+func (p ProfileCredentialProvider) Credential(ctx context.Context) (*Credential, error) {
+	path := p.Path
+	if path == "" {
+		var err error
+		if path, err = DefaultCredentialsFile(); err != nil {
+			return nil, err
+		}
+	}
+	profile := p.Profile
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credentials file: %w", err)
+	}
+	defer file.Close()
+
+	section := ""
+	found := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			found = found || section == profile
+			continue
+		}
+		if section != profile {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if ok && strings.TrimSpace(key) == "api_token" {
+			token := strings.TrimSpace(value)
+			if token == "" {
+				return nil, fmt.Errorf("profile %q in credentials file %s has an empty api_token", profile, path)
+			}
+			return &Credential{Token: token}, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", profile, path)
+	}
+	return nil, fmt.Errorf("profile %q in credentials file %s has no api_token", profile, path)
+}
+
+// CredentialTokenSource is a TokenSource that obtains the token from a
+// CredentialProvider. The token is cached until it expires or HubSpot
+// rejects it.
+type CredentialTokenSource struct {
+	provider CredentialProvider
+
+	mu         sync.Mutex
+	credential *Credential
+}
+
+// NewCredentialTokenSource creates a TokenSource for the given provider
+func NewCredentialTokenSource(provider CredentialProvider) *CredentialTokenSource {
+	return &CredentialTokenSource{provider: provider}
+}
+
+// Token returns the cached token, obtaining a new one from the provider
+// first when there is none or it is about to expire
+func (s *CredentialTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.credential != nil && (s.credential.Expiry.IsZero() || time.Now().Add(TokenRefreshMargin).Before(s.credential.Expiry)) {
+		return s.credential.Token, nil
+	}
+
+	credential, err := s.provider.Credential(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.credential = credential
+
+	return credential.Token, nil
+}
+
+// Invalidate discards the cached token so the next call to Token obtains
+// it from the provider again, which may have been given a new one
+func (s *CredentialTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, static := s.provider.(StaticCredentialProvider); static {
+		return false
+	}
+	if s.credential != nil && s.credential.Token == token {
+		s.credential = nil
+	}
+	return true
+}
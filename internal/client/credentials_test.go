@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeFile writes content to a file in a temporary directory and returns its path
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	return path
+}
+
+// checkCredential compares the outcome of a credential provider with the
+// wanted token, or with an error containing wantErr
+func checkCredential(t *testing.T, credential *Credential, err error, wantToken, wantErr string) {
+	t.Helper()
+
+	if wantErr != "" {
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("got error %v, want one containing %q", err, wantErr)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if credential.Token != wantToken {
+		t.Errorf("got token %q, want %q", credential.Token, wantToken)
+	}
+}
+
+func TestFileCredentialProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   *string
+		wantToken string
+		wantErr   string
+	}{
+		{name: "token", content: ptrTo("pat-na1-file\n"), wantToken: "pat-na1-file"},
+		{name: "surrounding whitespace", content: ptrTo("  pat-na1-file \n\n"), wantToken: "pat-na1-file"},
+		{name: "empty", content: ptrTo(" \n"), wantErr: "is empty"},
+		{name: "missing", wantErr: "failed to read API token file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "token")
+			if tt.content != nil {
+				path = writeFile(t, "token", *tt.content)
+			}
+
+			credential, err := FileCredentialProvider{Path: path}.Credential(context.Background())
+			checkCredential(t, credential, err, tt.wantToken, tt.wantErr)
+		})
+	}
+}
+
+func TestCommandCredentialProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential commands are run by /bin/sh in this test")
+	}
+
+	tests := []struct {
+		name       string
+		command    string
+		wantToken  string
+		wantExpiry time.Time
+		wantErr    string
+	}{
+		{
+			name:      "token",
+			command:   `echo '{"token": "pat-na1-command"}'`,
+			wantToken: "pat-na1-command",
+		},
+		{
+			name:       "expiry",
+			command:    `echo '{"token": "pat-na1-command", "expires_at": "2030-01-02T03:04:05Z"}'`,
+			wantToken:  "pat-na1-command",
+			wantExpiry: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:    "invalid expiry",
+			command: `echo '{"token": "pat-na1-command", "expires_at": "tomorrow"}'`,
+			wantErr: "failed to parse expires_at",
+		},
+		{
+			name:    "no token",
+			command: `echo '{}'`,
+			wantErr: "has no token",
+		},
+		{
+			name:    "not JSON",
+			command: `echo pat-na1-command`,
+			wantErr: "failed to decode credential command output",
+		},
+		{
+			name:    "failure with stderr",
+			command: `echo 'vault is sealed' >&2; exit 3`,
+			wantErr: "vault is sealed",
+		},
+		{
+			name:    "failure without stderr",
+			command: `exit 3`,
+			wantErr: "exit status 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credential, err := CommandCredentialProvider{Command: tt.command}.Credential(context.Background())
+			checkCredential(t, credential, err, tt.wantToken, tt.wantErr)
+			if err == nil && !credential.Expiry.Equal(tt.wantExpiry) {
+				t.Errorf("got expiry %v, want %v", credential.Expiry, tt.wantExpiry)
+			}
+		})
+	}
+}
+
+func TestProfileCredentialProvider(t *testing.T) {
+	const credentials = `
+# HubSpot credentials
+[default]
+api_token = pat-na1-default
+
+[sandbox]
+; the sandbox portal
+api_token=pat-na1-sandbox
+
+[empty]
+api_token =
+
+[no-token]
+region = eu1
+`
+	path := writeFile(t, "credentials", credentials)
+
+	tests := []struct {
+		name      string
+		profile   string
+		wantToken string
+		wantErr   string
+	}{
+		{name: "default profile", wantToken: "pat-na1-default"},
+		{name: "named profile", profile: "sandbox", wantToken: "pat-na1-sandbox"},
+		{name: "empty token", profile: "empty", wantErr: "has an empty api_token"},
+		{name: "no token", profile: "no-token", wantErr: "has no api_token"},
+		{name: "unknown profile", profile: "production", wantErr: `profile "production" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credential, err := ProfileCredentialProvider{Path: path, Profile: tt.profile}.Credential(context.Background())
+			checkCredential(t, credential, err, tt.wantToken, tt.wantErr)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ProfileCredentialProvider{Path: filepath.Join(t.TempDir(), "credentials")}.Credential(context.Background())
+		checkCredential(t, nil, err, "", "failed to open credentials file")
+	})
+}
+
+func TestCredentialTokenSourceRefreshesExpiringToken(t *testing.T) {
+	calls := 0
+	provider := credentialProviderFunc(func(ctx context.Context) (*Credential, error) {
+		calls++
+		// The token expires within the refresh margin, so it is fetched again
+		return &Credential{Token: "pat-na1-expiring", Expiry: time.Now().Add(TokenRefreshMargin / 2)}, nil
+	})
+
+	s := NewCredentialTokenSource(provider)
+	for i := 0; i < 2; i++ {
+		if _, err := s.Token(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("got %d credential calls, want 2", calls)
+	}
+}
+
+// credentialProviderFunc adapts a function to a CredentialProvider
+type credentialProviderFunc func(ctx context.Context) (*Credential, error)
+
+func (f credentialProviderFunc) Credential(ctx context.Context) (*Credential, error) {
+	return f(ctx)
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Deal represents a HubSpot deal
+type Deal = CRMObject
+
+// CreateDeal creates a new deal in HubSpot
+func (c *Client) CreateDeal(ctx context.Context, properties map[string]interface{}) (*Deal, error) {
+	deal, err := c.CreateObject(ctx, ObjectTypeDeals, properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deal: %w", err)
+	}
+
+	return deal, nil
+}
+
+// GetDeal retrieves a deal by ID. HubSpot only returns its default
+// properties unless the properties to return are given.
+func (c *Client) GetDeal(ctx context.Context, id string, properties ...string) (*Deal, error) {
+	var opts *GetObjectOptions
+	if len(properties) > 0 {
+		opts = &GetObjectOptions{Properties: properties}
+	}
+
+	deal, err := c.GetObject(ctx, ObjectTypeDeals, id, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deal: %w", err)
+	}
+
+	return deal, nil
+}
+
+// UpdateDeal updates an existing deal
+func (c *Client) UpdateDeal(ctx context.Context, id string, properties map[string]interface{}) (*Deal, error) {
+	deal, err := c.UpdateObject(ctx, ObjectTypeDeals, id, properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update deal: %w", err)
+	}
+
+	return deal, nil
+}
+
+// DeleteDeal deletes a deal by ID
+func (c *Client) DeleteDeal(ctx context.Context, id string) error {
+	if err := c.ArchiveObject(ctx, ObjectTypeDeals, id); err != nil {
+		return fmt.Errorf("failed to delete deal: %w", err)
+	}
+
+	return nil
+}
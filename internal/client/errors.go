@@ -2,9 +2,35 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"sort"
+)
+
+// HubSpot error categories
+const (
+	CategoryValidationError       = "VALIDATION_ERROR"
+	CategoryConflict              = "CONFLICT"
+	CategoryObjectNotFound        = "OBJECT_NOT_FOUND"
+	CategoryMissingScopes         = "MISSING_SCOPES"
+	CategoryRateLimits            = "RATE_LIMITS"
+	CategoryPropertyDoesntExist   = "PROPERTY_DOESNT_EXIST"
+	CategoryInvalidAuthentication = "INVALID_AUTHENTICATION"
+	CategoryExpiredAuthentication = "EXPIRED_AUTHENTICATION"
+)
+
+// Errors matched by errors.Is against a HubSpotError, by category or HTTP status
+var (
+	ErrValidation          = errors.New("HubSpot validation error")
+	ErrConflict            = errors.New("HubSpot conflict")
+	ErrNotFound            = errors.New("HubSpot object not found")
+	ErrMissingScopes       = errors.New("HubSpot token is missing required scopes")
+	ErrRateLimited         = errors.New("HubSpot rate limit exceeded")
+	ErrPropertyDoesntExist = errors.New("HubSpot property does not exist")
+	ErrUnauthorized        = errors.New("HubSpot authentication failed")
 )
 
 // HubSpotError represents an error response from the HubSpot API
@@ -14,7 +40,20 @@ type HubSpotError struct {
 	Category    string                 `json:"category"`
 	SubCategory string                 `json:"subCategory,omitempty"`
 	Context     map[string]interface{} `json:"context,omitempty"`
+	Errors      []ErrorDetail          `json:"errors,omitempty"`
 	StatusCode  int                    `json:"-"`
+
+	// response is the error response, whose body has been consumed
+	response *http.Response
+}
+
+// ErrorDetail describes one of the problems reported by an error response
+type ErrorDetail struct {
+	Message     string              `json:"message"`
+	Code        string              `json:"code,omitempty"`
+	SubCategory string              `json:"subCategory,omitempty"`
+	In          string              `json:"in,omitempty"`
+	Context     map[string][]string `json:"context,omitempty"`
 }
 
 // Error implements the error interface
@@ -25,9 +64,95 @@ func (e *HubSpotError) Error() string {
 	return fmt.Sprintf("HubSpot API error (%s): %s", e.Status, e.Message)
 }
 
-// IsNotFound returns true if the error is a 404 Not Found error
+// IsNotFound returns true if the error is a 404 Not Found error, or an
+// OBJECT_NOT_FOUND error reported without a 404 status such as in batch responses
 func (e *HubSpotError) IsNotFound() bool {
-	return e.StatusCode == http.StatusNotFound
+	return e.StatusCode == http.StatusNotFound || e.Status == "404" || e.Category == CategoryObjectNotFound
+}
+
+// IsNotFound reports whether err, or any error it wraps, is a HubSpot not found error
+func IsNotFound(err error) bool {
+	var hubspotErr *HubSpotError
+	return errors.As(err, &hubspotErr) && hubspotErr.IsNotFound()
+}
+
+// Is matches the error against the category errors of this package, so
+// callers can use errors.Is(err, client.ErrMissingScopes) and the like
+func (e *HubSpotError) Is(target error) bool {
+	switch target {
+	case ErrValidation:
+		return e.Category == CategoryValidationError || e.Category == CategoryPropertyDoesntExist
+	case ErrConflict:
+		return e.Category == CategoryConflict || e.StatusCode == http.StatusConflict
+	case ErrNotFound:
+		return e.IsNotFound()
+	case ErrMissingScopes:
+		return e.Category == CategoryMissingScopes
+	case ErrRateLimited:
+		return e.Category == CategoryRateLimits || e.IsRateLimited()
+	case ErrPropertyDoesntExist:
+		return len(e.InvalidProperties(CategoryPropertyDoesntExist)) > 0 || e.Category == CategoryPropertyDoesntExist
+	case ErrUnauthorized:
+		return e.IsAuthError() || e.Category == CategoryInvalidAuthentication || e.Category == CategoryExpiredAuthentication
+	}
+	return false
+}
+
+// RequiredScopes returns the scopes a MISSING_SCOPES error reports as required
+func (e *HubSpotError) RequiredScopes() []string {
+	var scopes []string
+	for _, key := range []string{"requiredGranularScopes", "requiredScopes"} {
+		values, _ := e.Context[key].([]interface{})
+		for _, value := range values {
+			if scope, ok := value.(string); ok {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
+// propertyErrorRegex matches the per-property errors HubSpot embeds as JSON
+// in the message of a property validation error
+var propertyErrorRegex = regexp.MustCompile(`\\?"error\\?":\\?"([A-Z_]+)\\?",\\?"name\\?":\\?"([^"\\]+)`)
+
+// InvalidProperties returns the names of the properties the error reports
+// as invalid, optionally only those with one of the given error codes (e.g.
+// PROPERTY_DOESNT_EXIST or INVALID_OPTION)
+func (e *HubSpotError) InvalidProperties(codes ...string) []string {
+	wanted := func(code string) bool {
+		if len(codes) == 0 {
+			return true
+		}
+		for _, c := range codes {
+			if c == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	names := map[string]bool{}
+	for _, detail := range e.Errors {
+		if !wanted(detail.Code) {
+			continue
+		}
+		for _, name := range detail.Context["propertyName"] {
+			names[name] = true
+		}
+	}
+	for _, match := range propertyErrorRegex.FindAllStringSubmatch(e.Message, -1) {
+		if wanted(match[1]) {
+			names[match[2]] = true
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
 }
 
 // IsRateLimited returns true if the error is a 429 Rate Limit error
@@ -53,6 +178,7 @@ func parseErrorResponse(resp *http.Response) error {
 			Status:     "error",
 			Message:    fmt.Sprintf("Failed to read error response: %v", err),
 			StatusCode: resp.StatusCode,
+			response:   resp,
 		}
 	}
 
@@ -63,9 +189,27 @@ func parseErrorResponse(resp *http.Response) error {
 			Status:     "error",
 			Message:    fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(body)),
 			StatusCode: resp.StatusCode,
+			response:   resp,
 		}
 	}
 
 	hubspotErr.StatusCode = resp.StatusCode
+	hubspotErr.response = resp
 	return &hubspotErr
 }
+
+// transportError is a request that failed without a response, such as on a
+// timeout or connection reset, so HubSpot may or may not have processed it
+type transportError struct {
+	err error
+}
+
+// Error implements the error interface
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the HTTP client
+func (e *transportError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "status code",
+			err:  &HubSpotError{StatusCode: http.StatusNotFound},
+			want: true,
+		},
+		{
+			name: "wrapped status code",
+			err:  fmt.Errorf("failed to get contact: %w", &HubSpotError{StatusCode: http.StatusNotFound}),
+			want: true,
+		},
+		{
+			name: "status string",
+			err:  fmt.Errorf("failed to get contact: %w", &HubSpotError{Status: "404"}),
+			want: true,
+		},
+		{
+			name: "object not found category",
+			err:  fmt.Errorf("failed to get contact: %w", &HubSpotError{StatusCode: http.StatusBadRequest, Category: CategoryObjectNotFound}),
+			want: true,
+		},
+		{
+			name: "other status",
+			err:  fmt.Errorf("failed to get contact: %w", &HubSpotError{StatusCode: http.StatusBadRequest, Category: CategoryValidationError}),
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+			if tt.err != nil {
+				if got := errors.Is(tt.err, ErrNotFound); got != tt.want {
+					t.Errorf("errors.Is(err, ErrNotFound) = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("got method %s, want DELETE", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":"error","message":"resource not found","category":"OBJECT_NOT_FOUND"}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, nil)
+
+	err := c.DeleteContact(context.Background(), "1")
+	if err == nil {
+		t.Fatal("expected an error for a missing contact")
+	}
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// retriesDisabledKey is the context key that disables retries of a request
+type retriesDisabledKey struct{}
+
+// withoutRetries returns a context whose requests are sent only once
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retriesDisabledKey{}, true)
+}
+
+// retriesDisabled reports whether retries are disabled for the context
+func retriesDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(retriesDisabledKey{}).(bool)
+	return disabled
+}
+
+// CreateKey identifies the record a create produces by the value of a
+// unique property, so an ambiguous create can be reconciled
+type CreateKey struct {
+	// Property is the name of the unique property, e.g. email for contacts
+	Property string
+	// Value is the value of the property in the created record
+	Value string
+	// Search looks the record up with a search instead of a read by
+	// idProperty, for properties that are not unique identifiers such as
+	// the company domain. Search results lag behind writes by a few seconds.
+	Search bool
+}
+
+// CreateObjectReconciled creates a CRM object without risking duplicates.
+// When a create attempt fails ambiguously (a transport error such as a
+// timeout or connection reset, or a 5xx, after which HubSpot may or may not
+// have created the record), the record is
+// looked up by key before the create is retried, and adopted when found. A
+// conflict after an ambiguous attempt is resolved the same way; a conflict
+// on the first attempt is returned as is.
+func (c *Client) CreateObjectReconciled(ctx context.Context, objectType string, properties map[string]interface{}, key CreateKey) (*CRMObject, error) {
+	ambiguous := false
+
+	for attempt := 0; ; attempt++ {
+		object, err := c.CreateObject(withoutRetries(ctx), objectType, properties)
+		if err == nil {
+			return object, nil
+		}
+		if ctx.Err() != nil || errors.Is(err, ErrDailyLimitReached) {
+			return nil, err
+		}
+
+		// Errors raised before the request was sent, such as token or
+		// marshalling errors, cannot have created a record
+		var hubspotErr *HubSpotError
+		var transportErr *transportError
+		var resp *http.Response
+		switch {
+		case errors.As(err, &transportErr):
+			ambiguous = true
+		case !errors.As(err, &hubspotErr):
+			return nil, err
+		case hubspotErr.IsServerError():
+			ambiguous = true
+		case hubspotErr.StatusCode == http.StatusConflict && ambiguous:
+		case hubspotErr.IsRateLimited():
+			resp = hubspotErr.response
+		default:
+			return nil, err
+		}
+
+		if ambiguous {
+			existing, lookupErr := c.findCreated(ctx, objectType, key)
+			if lookupErr == nil {
+				return existing, nil
+			}
+
+			if !IsNotFound(lookupErr) {
+				return nil, fmt.Errorf("%w (reconciling by %s %s also failed: %v)", err, key.Property, key.Value, lookupErr)
+			}
+		}
+
+		if attempt >= c.retryConfig.MaxRetries {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(calculateBackoff(attempt, c.retryConfig, resp)):
+		}
+	}
+}
+
+// findCreated looks up the record an ambiguous create may have produced
+func (c *Client) findCreated(ctx context.Context, objectType string, key CreateKey) (*CRMObject, error) {
+	if key.Search {
+		return c.findObjectByProperty(ctx, objectType, key.Property, key.Value)
+	}
+
+	return c.GetObject(ctx, objectType, key.Value, &GetObjectOptions{IDProperty: key.Property})
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreateObjectReconciledAdoptsTimedOutCreate(t *testing.T) {
+	var mu sync.Mutex
+	created := false
+	creates := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/crm/v3/objects/contacts":
+			mu.Lock()
+			creates++
+			created = true
+			mu.Unlock()
+
+			// The record is written, but the response arrives too late
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(w, `{"id":"1","properties":{"email":"jane@example.com"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/crm/v3/objects/contacts/jane@example.com":
+			if got := r.URL.Query().Get("idProperty"); got != "email" {
+				t.Errorf("got idProperty %q, want email", got)
+			}
+
+			mu.Lock()
+			exists := created
+			mu.Unlock()
+
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"status":"error","message":"not found","category":"OBJECT_NOT_FOUND"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":"1","properties":{"email":"jane@example.com"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		APIToken:        "pat-test-token",
+		BaseURL:         server.URL,
+		BatchReadWindow: -1,
+		Timeout:         50 * time.Millisecond,
+	})
+
+	object, err := c.CreateObjectReconciled(context.Background(), ObjectTypeContacts,
+		map[string]interface{}{"email": "jane@example.com"},
+		CreateKey{Property: "email", Value: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if object.ID != "1" {
+		t.Errorf("got contact %s, want the existing contact 1", object.ID)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if creates != 1 {
+		t.Errorf("got %d creates, want 1", creates)
+	}
+}
+
+func TestCreateObjectReconciledUnsentRequest(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	// An unsupported API version fails before any request is sent
+	c := NewClient(Config{APIToken: "pat-test-token", BaseURL: server.URL, APIVersion: "v4"})
+
+	_, err := c.CreateObjectReconciled(context.Background(), ObjectTypeContacts,
+		map[string]interface{}{"email": "jane@example.com"},
+		CreateKey{Property: "email", Value: "jane@example.com"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 0 {
+		t.Errorf("got %d requests, want none to reconcile an unsent create", requests)
+	}
+}
+
+func TestCreateObjectReconciledHonoursRetryAfter(t *testing.T) {
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(times) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"status":"error","message":"slow down","category":"RATE_LIMITS"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1","properties":{}}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, nil)
+
+	if _, err := c.CreateObjectReconciled(context.Background(), ObjectTypeContacts,
+		map[string]interface{}{"email": "jane@example.com"},
+		CreateKey{Property: "email", Value: "jane@example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(times) != 2 {
+		t.Fatalf("got %d requests, want 2", len(times))
+	}
+	if wait := times[1].Sub(times[0]); wait < time.Second {
+		t.Errorf("retried after %v, want the Retry-After of 1s", wait)
+	}
+}
@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard HubSpot CRM object types. Custom objects are addressed by their
+// object type ID (e.g. "2-1234567") or fully qualified name.
+const (
+	ObjectTypeContacts  = "contacts"
+	ObjectTypeCompanies = "companies"
+	ObjectTypeDeals     = "deals"
+	ObjectTypeTickets   = "tickets"
+	ObjectTypeLineItems = "line_items"
+	ObjectTypeProducts  = "products"
+	ObjectTypeQuotes    = "quotes"
+)
+
+// CRMObject represents a record of any HubSpot CRM object type
+type CRMObject struct {
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	UpdatedAt  time.Time              `json:"updatedAt"`
+	Archived   bool                   `json:"archived"`
+}
+
+// CRMObjectRequest represents the request body for creating/updating CRM objects
+type CRMObjectRequest struct {
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GetObjectOptions holds the optional query parameters for reading a CRM object
+type GetObjectOptions struct {
+	// Properties lists the properties to return. HubSpot returns a default
+	// set of properties when empty.
+	Properties []string
+	// IDProperty names a unique-value property to look the object up by
+	// instead of its record ID.
+	IDProperty string
+}
+
+// ListObjectsResponse represents a page of CRM objects
+type ListObjectsResponse struct {
+	Results []CRMObject `json:"results"`
+	Paging  *Paging     `json:"paging,omitempty"`
+}
+
+// Paging holds the cursor to the next page of a paginated response
+type Paging struct {
+	Next *PagingNext `json:"next,omitempty"`
+}
+
+// PagingNext identifies the next page of a paginated response
+type PagingNext struct {
+	After string `json:"after"`
+}
+
+// objectsPath builds the path for a CRM object type, optionally followed by
+// additional path segments
+func (c *Client) objectsPath(objectType string, segments ...string) string {
+	return c.routePath(RouteObjects, append([]string{objectType}, segments...)...)
+}
+
+// CreateObject creates a new CRM object of the given type
+func (c *Client) CreateObject(ctx context.Context, objectType string, properties map[string]interface{}) (*CRMObject, error) {
+	reqBody := CRMObjectRequest{
+		Properties: properties,
+	}
+
+	resp, err := c.Post(ctx, c.objectsPath(objectType), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s object: %w", objectType, err)
+	}
+
+	var object CRMObject
+	if err := DecodeResponse(resp, &object); err != nil {
+		return nil, fmt.Errorf("failed to decode %s object response: %w", objectType, err)
+	}
+
+	return &object, nil
+}
+
+// GetObject retrieves a CRM object of the given type by ID
+func (c *Client) GetObject(ctx context.Context, objectType, id string, opts *GetObjectOptions) (*CRMObject, error) {
+	// Reads by record ID are combined with concurrent reads into batch reads
+	if c.reads != nil && (opts == nil || opts.IDProperty == "") {
+		var properties []string
+		if opts != nil {
+			properties = opts.Properties
+		}
+
+		object, err := c.reads.get(ctx, objectType, id, properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s object: %w", objectType, err)
+		}
+		return object, nil
+	}
+
+	object, err := c.getObject(ctx, objectType, id, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s object: %w", objectType, err)
+	}
+
+	return object, nil
+}
+
+// getObject reads a single CRM object with a request of its own, bypassing
+// the read coalescer
+func (c *Client) getObject(ctx context.Context, objectType, id string, opts *GetObjectOptions) (*CRMObject, error) {
+	path := c.objectsPath(objectType, id)
+
+	if opts != nil {
+		query := url.Values{}
+		if len(opts.Properties) > 0 {
+			query.Set("properties", strings.Join(opts.Properties, ","))
+		}
+		if opts.IDProperty != "" {
+			query.Set("idProperty", opts.IDProperty)
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var object CRMObject
+	if err := DecodeResponse(resp, &object); err != nil {
+		return nil, fmt.Errorf("failed to decode %s object response: %w", objectType, err)
+	}
+
+	return &object, nil
+}
+
+// UpdateObject updates the properties of an existing CRM object
+func (c *Client) UpdateObject(ctx context.Context, objectType, id string, properties map[string]interface{}) (*CRMObject, error) {
+	reqBody := CRMObjectRequest{
+		Properties: properties,
+	}
+
+	resp, err := c.Patch(ctx, c.objectsPath(objectType, id), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s object: %w", objectType, err)
+	}
+
+	var object CRMObject
+	if err := DecodeResponse(resp, &object); err != nil {
+		return nil, fmt.Errorf("failed to decode %s object response: %w", objectType, err)
+	}
+
+	return &object, nil
+}
+
+// ArchiveObject archives (soft-deletes) a CRM object by ID
+func (c *Client) ArchiveObject(ctx context.Context, objectType, id string) error {
+	resp, err := c.Delete(ctx, c.objectsPath(objectType, id))
+	if err != nil {
+		return fmt.Errorf("failed to archive %s object: %w", objectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListObjects returns a page of up to limit CRM objects of the given type,
+// starting after the given paging cursor
+func (c *Client) ListObjects(ctx context.Context, objectType string, limit int, after string) (*ListObjectsResponse, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if after != "" {
+		query.Set("after", after)
+	}
+
+	resp, err := c.Get(ctx, c.objectsPath(objectType)+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s objects: %w", objectType, err)
+	}
+
+	var page ListObjectsResponse
+	if err := DecodeResponse(resp, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode %s objects response: %w", objectType, err)
+	}
+
+	return &page, nil
+}
+
+// HasObjects reports whether any (non-archived) CRM objects of the given type exist
+func (c *Client) HasObjects(ctx context.Context, objectType string) (bool, error) {
+	page, err := c.ListObjects(ctx, objectType, 1, "")
+	if err != nil {
+		return false, err
+	}
+
+	return len(page.Results) > 0, nil
+}
+
+// findObjectByProperty returns the first CRM object whose property equals
+// value, or a not-found error when there is no match
+func (c *Client) findObjectByProperty(ctx context.Context, objectType, property, value string) (*CRMObject, error) {
+	searchReq := SearchRequest{
+		FilterGroups: []FilterGroup{
+			{
+				Filters: []Filter{
+					{
+						PropertyName: property,
+						Operator:     OperatorEQ,
+						Value:        value,
+					},
+				},
+			},
+		},
+	}
+
+	searchResp, err := c.SearchObjects(ctx, objectType, searchReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if searchResp.Total == 0 || len(searchResp.Results) == 0 {
+		return nil, &HubSpotError{
+			Status:     "404",
+			Message:    fmt.Sprintf("%s object with %s %s not found", objectType, property, value),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+
+	return &searchResp.Results[0], nil
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// objectRequest is a request received by the test server
+type objectRequest struct {
+	method string
+	path   string
+	query  string
+	body   map[string]interface{}
+}
+
+func TestObjectCRUD(t *testing.T) {
+	var requests []objectRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := objectRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery}
+		if r.Body != nil && r.Method != http.MethodGet && r.Method != http.MethodDelete {
+			if err := json.NewDecoder(r.Body).Decode(&req.body); err != nil {
+				t.Errorf("decoding request body: %v", err)
+			}
+		}
+		requests = append(requests, req)
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"42","properties":{"name":"Acme"},"archived":false}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, nil)
+	ctx := context.Background()
+	properties := map[string]interface{}{"name": "Acme"}
+
+	created, err := c.CreateObject(ctx, "2-123", properties)
+	if err != nil {
+		t.Fatalf("create: unexpected error: %v", err)
+	}
+	if created.ID != "42" || created.Properties["name"] != "Acme" {
+		t.Errorf("create: got %+v", created)
+	}
+
+	got, err := c.GetObject(ctx, "2-123", "42", &GetObjectOptions{Properties: []string{"name", "size"}})
+	if err != nil {
+		t.Fatalf("get: unexpected error: %v", err)
+	}
+	if got.ID != "42" {
+		t.Errorf("get: got object %s, want 42", got.ID)
+	}
+
+	if _, err := c.UpdateObject(ctx, "2-123", "42", properties); err != nil {
+		t.Fatalf("update: unexpected error: %v", err)
+	}
+
+	if err := c.ArchiveObject(ctx, "2-123", "42"); err != nil {
+		t.Fatalf("archive: unexpected error: %v", err)
+	}
+
+	want := []objectRequest{
+		{method: http.MethodPost, path: "/crm/v3/objects/2-123"},
+		{method: http.MethodGet, path: "/crm/v3/objects/2-123/42", query: "properties=name%2Csize"},
+		{method: http.MethodPatch, path: "/crm/v3/objects/2-123/42"},
+		{method: http.MethodDelete, path: "/crm/v3/objects/2-123/42"},
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("got %d requests, want %d", len(requests), len(want))
+	}
+	for i, w := range want {
+		r := requests[i]
+		if r.method != w.method || r.path != w.path || r.query != w.query {
+			t.Errorf("request %d: got %s %s?%s, want %s %s?%s", i, r.method, r.path, r.query, w.method, w.path, w.query)
+		}
+	}
+	for _, i := range []int{0, 2} {
+		props, _ := requests[i].body["properties"].(map[string]interface{})
+		if props["name"] != "Acme" {
+			t.Errorf("request %d: got body %v, want properties.name Acme", i, requests[i].body)
+		}
+	}
+}
+
+func TestGetObjectByIDProperty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/crm/v3/objects/contacts/jane@example.com" || r.URL.Query().Get("idProperty") != "email" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"7","properties":{"email":"jane@example.com"}}`)
+	}))
+	defer server.Close()
+
+	// Reads by idProperty bypass the read coalescer
+	c := NewClient(Config{APIToken: "pat-test-token", BaseURL: server.URL})
+
+	object, err := c.GetObject(context.Background(), ObjectTypeContacts, "jane@example.com", &GetObjectOptions{IDProperty: "email"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if object.ID != "7" {
+		t.Errorf("got object %s, want 7", object.ID)
+	}
+}
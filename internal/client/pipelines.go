@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Pipeline represents a HubSpot pipeline for deals, tickets or other pipelined objects
+type Pipeline struct {
+	ID           string          `json:"id"`
+	Label        string          `json:"label"`
+	DisplayOrder int             `json:"displayOrder"`
+	Stages       []PipelineStage `json:"stages"`
+	Archived     bool            `json:"archived"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// PipelineStage represents a single stage within a pipeline
+type PipelineStage struct {
+	ID           string            `json:"id"`
+	Label        string            `json:"label"`
+	DisplayOrder int               `json:"displayOrder"`
+	Metadata     map[string]string `json:"metadata"`
+	Archived     bool              `json:"archived"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+}
+
+// PipelineInput represents the request body for creating or updating a
+// pipeline. Stages are only sent on create; existing stages are managed
+// through the stage endpoints so their IDs are preserved.
+type PipelineInput struct {
+	Label        string               `json:"label"`
+	DisplayOrder int                  `json:"displayOrder"`
+	Stages       []PipelineStageInput `json:"stages,omitempty"`
+}
+
+// PipelineStageInput represents the request body for creating or updating a pipeline stage
+type PipelineStageInput struct {
+	Label        string            `json:"label"`
+	DisplayOrder int               `json:"displayOrder"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+// Stage returns the stage with the given ID, or nil if the pipeline has no such stage
+func (p *Pipeline) Stage(id string) *PipelineStage {
+	for i := range p.Stages {
+		if p.Stages[i].ID == id {
+			return &p.Stages[i]
+		}
+	}
+	return nil
+}
+
+// pipelinesPath builds the path for the pipelines of an object type,
+// optionally followed by additional path segments
+func (c *Client) pipelinesPath(objectType string, segments ...string) string {
+	return c.routePath(RoutePipelines, append([]string{objectType}, segments...)...)
+}
+
+// GetPipeline retrieves a pipeline and its stages by ID
+func (c *Client) GetPipeline(ctx context.Context, objectType, pipelineID string) (*Pipeline, error) {
+	resp, err := c.Get(ctx, c.pipelinesPath(objectType, pipelineID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s pipeline: %w", objectType, err)
+	}
+
+	var pipeline Pipeline
+	if err := DecodeResponse(resp, &pipeline); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline response: %w", err)
+	}
+
+	return &pipeline, nil
+}
+
+// CreatePipeline creates a new pipeline with its initial stages
+func (c *Client) CreatePipeline(ctx context.Context, objectType string, pipeline *PipelineInput) (*Pipeline, error) {
+	reqBody := PipelineInput{
+		Label:        pipeline.Label,
+		DisplayOrder: pipeline.DisplayOrder,
+	}
+	for i := range pipeline.Stages {
+		reqBody.Stages = append(reqBody.Stages, *normalizeStageInput(&pipeline.Stages[i]))
+	}
+
+	resp, err := c.Post(ctx, c.pipelinesPath(objectType), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pipeline: %w", objectType, err)
+	}
+
+	var created Pipeline
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdatePipeline updates the label and display order of a pipeline
+func (c *Client) UpdatePipeline(ctx context.Context, objectType, pipelineID string, pipeline *PipelineInput) (*Pipeline, error) {
+	reqBody := PipelineInput{
+		Label:        pipeline.Label,
+		DisplayOrder: pipeline.DisplayOrder,
+	}
+
+	resp, err := c.Patch(ctx, c.pipelinesPath(objectType, pipelineID), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s pipeline: %w", objectType, err)
+	}
+
+	var updated Pipeline
+	if err := DecodeResponse(resp, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeletePipeline deletes a pipeline. HubSpot refuses the deletion while
+// records still reference any of its stages.
+func (c *Client) DeletePipeline(ctx context.Context, objectType, pipelineID string) error {
+	path := c.pipelinesPath(objectType, pipelineID) + "?validateReferencesBeforeDelete=true"
+
+	resp, err := c.Delete(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s pipeline: %w", objectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// CreatePipelineStage adds a stage to an existing pipeline
+func (c *Client) CreatePipelineStage(ctx context.Context, objectType, pipelineID string, stage *PipelineStageInput) (*PipelineStage, error) {
+	resp, err := c.Post(ctx, c.pipelinesPath(objectType, pipelineID, "stages"), normalizeStageInput(stage))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pipeline stage: %w", objectType, err)
+	}
+
+	var created PipelineStage
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline stage response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetPipelineStage retrieves a single pipeline stage by ID
+func (c *Client) GetPipelineStage(ctx context.Context, objectType, pipelineID, stageID string) (*PipelineStage, error) {
+	resp, err := c.Get(ctx, c.pipelinesPath(objectType, pipelineID, "stages", stageID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s pipeline stage: %w", objectType, err)
+	}
+
+	var stage PipelineStage
+	if err := DecodeResponse(resp, &stage); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline stage response: %w", err)
+	}
+
+	return &stage, nil
+}
+
+// UpdatePipelineStage updates the label, display order and metadata of a pipeline stage
+func (c *Client) UpdatePipelineStage(ctx context.Context, objectType, pipelineID, stageID string, stage *PipelineStageInput) (*PipelineStage, error) {
+	resp, err := c.Patch(ctx, c.pipelinesPath(objectType, pipelineID, "stages", stageID), normalizeStageInput(stage))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s pipeline stage: %w", objectType, err)
+	}
+
+	var updated PipelineStage
+	if err := DecodeResponse(resp, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline stage response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeletePipelineStage deletes a pipeline stage. HubSpot refuses the deletion
+// while records are still in the stage.
+func (c *Client) DeletePipelineStage(ctx context.Context, objectType, pipelineID, stageID string) error {
+	resp, err := c.Delete(ctx, c.pipelinesPath(objectType, pipelineID, "stages", stageID))
+	if err != nil {
+		return fmt.Errorf("failed to delete %s pipeline stage: %w", objectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// normalizeStageInput ensures metadata is sent as an object rather than null
+func normalizeStageInput(stage *PipelineStageInput) *PipelineStageInput {
+	if stage.Metadata != nil {
+		return stage
+	}
+
+	normalized := *stage
+	normalized.Metadata = map[string]string{}
+	return &normalized
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// PropertyDefinition represents a HubSpot property definition
+type PropertyDefinition struct {
+	Name           string   `json:"name"`
+	Label          string   `json:"label"`
+	Type           string   `json:"type"`
+	FieldType      string   `json:"fieldType"`
+	GroupName      string   `json:"groupName,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Options        []Option `json:"options,omitempty"`
+	HasUniqueValue bool     `json:"hasUniqueValue,omitempty"`
+	Hidden         bool     `json:"hidden"`
+	Calculated     bool     `json:"calculated,omitempty"`
+	Archived       bool     `json:"archived,omitempty"`
+	HubSpotDefined bool     `json:"hubspotDefined,omitempty"`
+}
+
+// Option represents a selectable value of an enumeration property
+type Option struct {
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+	Description  string `json:"description,omitempty"`
+	DisplayOrder int    `json:"displayOrder"`
+	Hidden       bool   `json:"hidden"`
+}
+
+// propertyUpdateRequest represents the request body for updating a property.
+// The name and hasUniqueValue of a property cannot be changed after creation.
+type propertyUpdateRequest struct {
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`
+	FieldType   string   `json:"fieldType"`
+	GroupName   string   `json:"groupName"`
+	Description string   `json:"description"`
+	Options     []Option `json:"options,omitempty"`
+	Hidden      bool     `json:"hidden"`
+}
+
+// propertiesPath builds the path for the properties of an object type,
+// optionally followed by a property name
+func (c *Client) propertiesPath(objectType string, segments ...string) string {
+	return c.routePath(RouteProperties, append([]string{objectType}, segments...)...)
+}
+
+// CreateProperty creates a new property definition for an object type
+func (c *Client) CreateProperty(ctx context.Context, objectType string, property *PropertyDefinition) (*PropertyDefinition, error) {
+	resp, err := c.Post(ctx, c.propertiesPath(objectType), property)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s property: %w", objectType, err)
+	}
+
+	var created PropertyDefinition
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode property response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetProperty retrieves a property definition by name
+func (c *Client) GetProperty(ctx context.Context, objectType, propertyName string) (*PropertyDefinition, error) {
+	resp, err := c.Get(ctx, c.propertiesPath(objectType, propertyName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s property: %w", objectType, err)
+	}
+
+	var property PropertyDefinition
+	if err := DecodeResponse(resp, &property); err != nil {
+		return nil, fmt.Errorf("failed to decode property response: %w", err)
+	}
+
+	return &property, nil
+}
+
+// UpdateProperty updates the mutable fields of an existing property definition
+func (c *Client) UpdateProperty(ctx context.Context, objectType, propertyName string, property *PropertyDefinition) (*PropertyDefinition, error) {
+	reqBody := propertyUpdateRequest{
+		Label:       property.Label,
+		Type:        property.Type,
+		FieldType:   property.FieldType,
+		GroupName:   property.GroupName,
+		Description: property.Description,
+		Options:     property.Options,
+		Hidden:      property.Hidden,
+	}
+
+	resp, err := c.Patch(ctx, c.propertiesPath(objectType, propertyName), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s property: %w", objectType, err)
+	}
+
+	var updated PropertyDefinition
+	if err := DecodeResponse(resp, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode property response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteProperty archives a property definition
+func (c *Client) DeleteProperty(ctx context.Context, objectType, propertyName string) error {
+	resp, err := c.Delete(ctx, c.propertiesPath(objectType, propertyName))
+	if err != nil {
+		return fmt.Errorf("failed to delete %s property: %w", objectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// PropertyGroup represents a HubSpot property group
+type PropertyGroup struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"displayOrder"`
+	Archived     bool   `json:"archived,omitempty"`
+}
+
+// propertyGroupUpdateRequest represents the request body for updating a
+// property group. The name of a group cannot be changed after creation.
+type propertyGroupUpdateRequest struct {
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// propertyGroupsPath builds the path for the property groups of an object
+// type, optionally followed by a group name
+func (c *Client) propertyGroupsPath(objectType string, segments ...string) string {
+	return c.propertiesPath(objectType, append([]string{"groups"}, segments...)...)
+}
+
+// CreatePropertyGroup creates a new property group for an object type
+func (c *Client) CreatePropertyGroup(ctx context.Context, objectType string, group *PropertyGroup) (*PropertyGroup, error) {
+	resp, err := c.Post(ctx, c.propertyGroupsPath(objectType), group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s property group: %w", objectType, err)
+	}
+
+	var created PropertyGroup
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode property group response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetPropertyGroup retrieves a property group by name
+func (c *Client) GetPropertyGroup(ctx context.Context, objectType, groupName string) (*PropertyGroup, error) {
+	resp, err := c.Get(ctx, c.propertyGroupsPath(objectType, groupName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s property group: %w", objectType, err)
+	}
+
+	var group PropertyGroup
+	if err := DecodeResponse(resp, &group); err != nil {
+		return nil, fmt.Errorf("failed to decode property group response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// UpdatePropertyGroup updates the label and display order of a property group
+func (c *Client) UpdatePropertyGroup(ctx context.Context, objectType, groupName string, group *PropertyGroup) (*PropertyGroup, error) {
+	reqBody := propertyGroupUpdateRequest{
+		Label:        group.Label,
+		DisplayOrder: group.DisplayOrder,
+	}
+
+	resp, err := c.Patch(ctx, c.propertyGroupsPath(objectType, groupName), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s property group: %w", objectType, err)
+	}
+
+	var updated PropertyGroup
+	if err := DecodeResponse(resp, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode property group response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeletePropertyGroup archives a property group
+func (c *Client) DeletePropertyGroup(ctx context.Context, objectType, groupName string) error {
+	resp, err := c.Delete(ctx, c.propertyGroupsPath(objectType, groupName))
+	if err != nil {
+		return fmt.Errorf("failed to delete %s property group: %w", objectType, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerInterval is the burst limit assumed until HubSpot reports
+// the actual limit of the account, matching the lowest private app tier
+const DefaultRequestsPerInterval = 100
+
+// DefaultRateLimitInterval is the window HubSpot applies burst limits to
+const DefaultRateLimitInterval = 10 * time.Second
+
+// HubSpot rate limit response headers
+const (
+	headerRateLimitMax            = "X-HubSpot-RateLimit-Max"
+	headerRateLimitRemaining      = "X-HubSpot-RateLimit-Remaining"
+	headerRateLimitInterval       = "X-HubSpot-RateLimit-Interval-Milliseconds"
+	headerRateLimitDaily          = "X-HubSpot-RateLimit-Daily"
+	headerRateLimitDailyRemaining = "X-HubSpot-RateLimit-Daily-Remaining"
+)
+
+// ErrDailyLimitReached is returned instead of sending a request once the
+// daily request limit has been used up
+var ErrDailyLimitReached = errors.New("HubSpot daily API request limit reached")
+
+// RateLimitConfig defines the request limits the client stays within
+type RateLimitConfig struct {
+	// RequestsPerInterval caps the requests per DefaultRateLimitInterval.
+	// When zero, the limit reported by HubSpot is used.
+	RequestsPerInterval int
+	// DailyLimit caps the requests per day. When zero, the limit reported
+	// by HubSpot is used.
+	DailyLimit int
+}
+
+// RateLimiter is a token bucket limiting the requests of a client. It is
+// safe for concurrent use and tunes itself to the limits and remaining
+// quota HubSpot reports in its response headers.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	configured RateLimitConfig
+	capacity   float64
+	interval   time.Duration
+	tokens     float64
+	updated    time.Time
+
+	// dailyRemaining is the remaining daily quota, or -1 when unknown
+	dailyLimit     int
+	dailyRemaining int
+}
+
+// NewRateLimiter creates a rate limiter with a full bucket
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	capacity := config.RequestsPerInterval
+	if capacity <= 0 {
+		capacity = DefaultRequestsPerInterval
+	}
+
+	l := &RateLimiter{
+		configured:     config,
+		capacity:       float64(capacity),
+		interval:       DefaultRateLimitInterval,
+		tokens:         float64(capacity),
+		updated:        time.Now(),
+		dailyLimit:     config.DailyLimit,
+		dailyRemaining: -1,
+	}
+	if config.DailyLimit > 0 {
+		l.dailyRemaining = config.DailyLimit
+	}
+
+	return l
+}
+
+// refill adds the tokens accrued since the last update. Callers must hold l.mu.
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.updated)
+	l.updated = now
+	l.tokens += elapsed.Seconds() * l.capacity / l.interval.Seconds()
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+}
+
+// Wait blocks until a request may be sent, or returns an error when the
+// context is done or the daily limit has been reached
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.dailyRemaining == 0 {
+			l.mu.Unlock()
+			return fmt.Errorf("%w (%d requests)", ErrDailyLimitReached, l.dailyLimit)
+		}
+
+		l.refill(time.Now())
+		if l.tokens >= 1 {
+			l.tokens--
+			if l.dailyRemaining > 0 {
+				l.dailyRemaining--
+			}
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) * float64(l.interval) / l.capacity)
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Update tunes the limiter to the rate limit headers of a HubSpot response.
+// Configured limits are never exceeded, and the bucket never holds more
+// tokens than HubSpot reports remaining, since other clients of the same
+// account share the quota.
+func (l *RateLimiter) Update(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+
+	if interval, ok := headerInt(header, headerRateLimitInterval); ok && interval > 0 {
+		l.interval = time.Duration(interval) * time.Millisecond
+	}
+	if limit, ok := headerInt(header, headerRateLimitMax); ok && limit > 0 {
+		if l.configured.RequestsPerInterval <= 0 || limit < l.configured.RequestsPerInterval {
+			l.capacity = float64(limit)
+		}
+	}
+	if remaining, ok := headerInt(header, headerRateLimitRemaining); ok && float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+
+	if daily, ok := headerInt(header, headerRateLimitDaily); ok && daily > 0 {
+		if l.configured.DailyLimit <= 0 || daily < l.configured.DailyLimit {
+			l.dailyLimit = daily
+		}
+	}
+	if remaining, ok := headerInt(header, headerRateLimitDailyRemaining); ok {
+		// The configured daily cap still applies to the requests of this run
+		if l.dailyRemaining < 0 || remaining < l.dailyRemaining {
+			l.dailyRemaining = remaining
+		}
+	}
+}
+
+// headerInt parses an integer response header
+func headerInt(header http.Header, name string) (int, bool) {
+	value := header.Get(name)
+	if value == "" {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func rateLimitHeader(values map[string]string) http.Header {
+	header := http.Header{}
+	for name, value := range values {
+		header.Set(name, value)
+	}
+	return header
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{RequestsPerInterval: 5})
+	// One token every 20ms
+	l.Update(rateLimitHeader(map[string]string{headerRateLimitInterval: "100"}))
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("wait %d: unexpected error: %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Errorf("a full bucket took %v for 5 requests, want no wait", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("an empty bucket took %v, want about 20ms", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{RequestsPerInterval: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       RateLimitConfig
+		header       map[string]string
+		wantCapacity float64
+		wantTokens   float64
+		wantInterval time.Duration
+	}{
+		{
+			name:         "reported limit",
+			header:       map[string]string{headerRateLimitMax: "190"},
+			wantCapacity: 190,
+			wantTokens:   DefaultRequestsPerInterval,
+			wantInterval: DefaultRateLimitInterval,
+		},
+		{
+			name:         "configured cap below reported limit",
+			config:       RateLimitConfig{RequestsPerInterval: 50},
+			header:       map[string]string{headerRateLimitMax: "190"},
+			wantCapacity: 50,
+			wantTokens:   50,
+			wantInterval: DefaultRateLimitInterval,
+		},
+		{
+			name:         "reported limit below configured cap",
+			config:       RateLimitConfig{RequestsPerInterval: 50},
+			header:       map[string]string{headerRateLimitMax: "20"},
+			wantCapacity: 20,
+			wantTokens:   20,
+			wantInterval: DefaultRateLimitInterval,
+		},
+		{
+			name:         "remaining quota shared with other clients",
+			header:       map[string]string{headerRateLimitRemaining: "7"},
+			wantCapacity: DefaultRequestsPerInterval,
+			wantTokens:   7,
+			wantInterval: DefaultRateLimitInterval,
+		},
+		{
+			name:         "interval",
+			header:       map[string]string{headerRateLimitInterval: "1000"},
+			wantCapacity: DefaultRequestsPerInterval,
+			wantTokens:   DefaultRequestsPerInterval,
+			wantInterval: time.Second,
+		},
+		{
+			name:         "malformed headers",
+			header:       map[string]string{headerRateLimitMax: "many", headerRateLimitRemaining: ""},
+			wantCapacity: DefaultRequestsPerInterval,
+			wantTokens:   DefaultRequestsPerInterval,
+			wantInterval: DefaultRateLimitInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(tt.config)
+			l.Update(rateLimitHeader(tt.header))
+
+			if l.capacity != tt.wantCapacity {
+				t.Errorf("got capacity %v, want %v", l.capacity, tt.wantCapacity)
+			}
+			// Tokens refill while the test runs, so allow for a fraction
+			if l.tokens < tt.wantTokens || l.tokens > tt.wantTokens+0.5 {
+				t.Errorf("got %v tokens, want %v", l.tokens, tt.wantTokens)
+			}
+			if l.interval != tt.wantInterval {
+				t.Errorf("got interval %v, want %v", l.interval, tt.wantInterval)
+			}
+		})
+	}
+}
+
+func TestRateLimiterDailyLimit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("configured", func(t *testing.T) {
+		l := NewRateLimiter(RateLimitConfig{DailyLimit: 2})
+		for i := 0; i < 2; i++ {
+			if err := l.Wait(ctx); err != nil {
+				t.Fatalf("wait %d: unexpected error: %v", i, err)
+			}
+		}
+		if err := l.Wait(ctx); !errors.Is(err, ErrDailyLimitReached) {
+			t.Errorf("got error %v, want ErrDailyLimitReached", err)
+		}
+	})
+
+	t.Run("reported", func(t *testing.T) {
+		l := NewRateLimiter(RateLimitConfig{})
+		l.Update(rateLimitHeader(map[string]string{
+			headerRateLimitDaily:          "250000",
+			headerRateLimitDailyRemaining: "0",
+		}))
+		if err := l.Wait(ctx); !errors.Is(err, ErrDailyLimitReached) {
+			t.Errorf("got error %v, want ErrDailyLimitReached", err)
+		}
+		if l.dailyLimit != 250000 {
+			t.Errorf("got daily limit %d, want 250000", l.dailyLimit)
+		}
+	})
+
+	t.Run("configured cap below reported remaining", func(t *testing.T) {
+		l := NewRateLimiter(RateLimitConfig{DailyLimit: 1})
+		l.Update(rateLimitHeader(map[string]string{
+			headerRateLimitDaily:          "250000",
+			headerRateLimitDailyRemaining: "1000",
+		}))
+		if l.dailyLimit != 1 || l.dailyRemaining != 1 {
+			t.Errorf("got daily limit %d with %d remaining, want the configured 1", l.dailyLimit, l.dailyRemaining)
+		}
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := l.Wait(ctx); !errors.Is(err, ErrDailyLimitReached) {
+			t.Errorf("got error %v, want ErrDailyLimitReached", err)
+		}
+	})
+}
@@ -92,12 +92,42 @@ func calculateBackoff(attempt int, config RetryConfig, resp *http.Response) time
 	return time.Duration(backoff)
 }
 
+// newAttempt returns a copy of req with a fresh body for a single attempt.
+// Requests built by http.NewRequest from an in-memory body can replay it
+// through GetBody; other requests with a body cannot be retried.
+func newAttempt(ctx context.Context, req *http.Request) (*http.Request, error) {
+	attempt := req.Clone(ctx)
+	if req.Body == nil || req.Body == http.NoBody {
+		return attempt, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("request body of %s %s cannot be replayed", req.Method, req.URL.Path)
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild request body: %w", err)
+	}
+	attempt.Body = body
+
+	return attempt, nil
+}
+
 // doWithRetry executes an HTTP request with retry logic
 func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var err error
 
-	for attempt := 0; attempt <= c.retryConfig.MaxRetries; attempt++ {
+	maxRetries := c.retryConfig.MaxRetries
+	if retriesDisabled(ctx) {
+		maxRetries = 0
+	}
+
+	// A rejected token is replaced and the request repeated once, without
+	// counting as a retry
+	reauthenticated := false
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
 		// Check if context is cancelled
 		select {
 		case <-ctx.Done():
@@ -105,8 +135,39 @@ func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Resp
 		default:
 		}
 
+		// Wait for the rate limiter before every attempt
+		if waitErr := c.rateLimiter.Wait(ctx); waitErr != nil {
+			return nil, waitErr
+		}
+
+		// Give every attempt a fresh copy of the request body, since the
+		// previous attempt consumed it
+		attemptReq, reqErr := newAttempt(ctx, req)
+		if reqErr != nil {
+			return nil, reqErr
+		}
+
+		token, authErr := c.addAuthHeader(ctx, attemptReq)
+		if authErr != nil {
+			return nil, authErr
+		}
+
 		// Execute the request
-		resp, err = c.httpClient.Do(req.WithContext(ctx))
+		resp, err = c.httpClient.Do(attemptReq)
+		if err == nil {
+			c.rateLimiter.Update(resp.Header)
+		} else {
+			err = &transportError{err: err}
+		}
+
+		// Repeat the attempt with a fresh token if HubSpot rejected the
+		// current one, e.g. because an OAuth access token expired early
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.tokens.Invalidate(token) {
+			reauthenticated = true
+			resp.Body.Close()
+			attempt--
+			continue
+		}
 
 		// If successful (2xx), return immediately
 		if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
@@ -123,9 +184,9 @@ func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Resp
 		}
 
 		// If this was the last attempt, return the error
-		if attempt == c.retryConfig.MaxRetries {
+		if attempt == maxRetries {
 			if err != nil {
-				return nil, fmt.Errorf("request failed after %d retries: %w", c.retryConfig.MaxRetries, err)
+				return nil, fmt.Errorf("request failed after %d retries: %w", maxRetries, err)
 			}
 			return resp, nil
 		}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingServer fails the first failures requests with status and then
+// succeeds, recording the method and body of every request
+type recordingServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	status   int
+	methods  []string
+	bodies   []string
+}
+
+func newRecordingServer(t *testing.T, failures, status int) *recordingServer {
+	t.Helper()
+
+	s := &recordingServer{failures: failures, status: status}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+
+		s.mu.Lock()
+		s.methods = append(s.methods, r.Method)
+		s.bodies = append(s.bodies, string(body))
+		fail := len(s.bodies) <= s.failures
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if fail {
+			w.WriteHeader(s.status)
+			fmt.Fprint(w, `{"status":"error","message":"try again","category":"INTERNAL_ERROR"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1","properties":{}}`)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func newTestClient(baseURL string, tokens TokenSource) *Client {
+	c := NewClient(Config{
+		APIToken:        "pat-test-token",
+		BaseURL:         baseURL,
+		BatchReadWindow: -1,
+		TokenSource:     tokens,
+	})
+	c.SetRetryConfig(RetryConfig{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	})
+	return c
+}
+
+func assertIdenticalBodies(t *testing.T, s *recordingServer, method string, attempts int, want string) {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.bodies) != attempts {
+		t.Fatalf("got %d attempts, want %d", len(s.bodies), attempts)
+	}
+	for i, body := range s.bodies {
+		if s.methods[i] != method {
+			t.Errorf("attempt %d: got method %s, want %s", i+1, s.methods[i], method)
+		}
+		if body != want {
+			t.Errorf("attempt %d: got body %q, want %q", i+1, body, want)
+		}
+	}
+}
+
+func TestDoWithRetryReplaysBody(t *testing.T) {
+	want := `{"properties":{"email":"jane@example.com"}}`
+	body := map[string]interface{}{"properties": map[string]string{"email": "jane@example.com"}}
+
+	tests := []struct {
+		method string
+		call   func(c *Client) (*http.Response, error)
+	}{
+		{
+			method: http.MethodPost,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Post(context.Background(), "crm/v3/objects/contacts", body)
+			},
+		},
+		{
+			method: http.MethodPatch,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Patch(context.Background(), "crm/v3/objects/contacts/1", body)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			s := newRecordingServer(t, 2, http.StatusInternalServerError)
+			c := newTestClient(s.URL, nil)
+
+			resp, err := tt.call(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			assertIdenticalBodies(t, s, tt.method, 3, want)
+		})
+	}
+}
+
+// rotatingTokenSource hands out a new token after every invalidation
+type rotatingTokenSource struct {
+	mu          sync.Mutex
+	generation  int
+	invalidated int
+}
+
+func (s *rotatingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fmt.Sprintf("token-%d", s.generation), nil
+}
+
+func (s *rotatingTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	s.invalidated++
+	return true
+}
+
+func TestDoWithRetryReplaysBodyAfterReauthentication(t *testing.T) {
+	s := newRecordingServer(t, 1, http.StatusUnauthorized)
+	tokens := &rotatingTokenSource{}
+	c := newTestClient(s.URL, tokens)
+
+	resp, err := c.Post(context.Background(), "crm/v3/objects/contacts", map[string]string{"email": "jane@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	assertIdenticalBodies(t, s, http.MethodPost, 2, `{"email":"jane@example.com"}`)
+	if tokens.invalidated != 1 {
+		t.Errorf("got %d invalidations, want 1", tokens.invalidated)
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DefaultAPIVersion is the API version of requests unless configured otherwise
+const DefaultAPIVersion = "v3"
+
+// APIVersions lists the API versions the client implements for every
+// versioned route, i.e. the values APIVersion may take. Only v3 is
+// implemented, so APIVersion is reserved for versions added to routeTable
+// later and cannot select anything else yet.
+var APIVersions = []string{"v3"}
+
+// Route identifies a group of HubSpot API endpoints that is versioned as a
+// whole
+type Route string
+
+// Routes of the HubSpot API
+const (
+	RouteObjects            Route = "objects"
+	RouteObjectAssociations Route = "object_associations"
+	RouteAssociations       Route = "associations"
+	RouteProperties         Route = "properties"
+	RoutePipelines          Route = "pipelines"
+	RouteSchemas            Route = "schemas"
+	RouteAccountInfo        Route = "account_info"
+	RouteOAuth              Route = "oauth"
+	RoutePrivateApps        Route = "private_apps"
+)
+
+// routeSpec describes the versions a route is served at
+type routeSpec struct {
+	// prefixes maps each API version the client implements the route for
+	// to the path prefix of the route in that version
+	prefixes map[string]string
+	// pinned routes are only implemented for a single version and ignore
+	// the configured API version
+	pinned bool
+}
+
+// routeTable lists the versions of every route. Association endpoints are
+// only implemented for v4, which added association labels.
+var routeTable = map[Route]routeSpec{
+	RouteObjects: {
+		prefixes: map[string]string{"v3": "crm/v3/objects"},
+	},
+	RouteProperties: {
+		prefixes: map[string]string{"v3": "crm/v3/properties"},
+	},
+	RoutePipelines: {
+		prefixes: map[string]string{"v3": "crm/v3/pipelines"},
+	},
+	RouteSchemas: {
+		prefixes: map[string]string{"v3": "crm/v3/schemas"},
+	},
+	RouteObjectAssociations: {
+		prefixes: map[string]string{"v4": "crm/v4/objects"},
+		pinned:   true,
+	},
+	RouteAssociations: {
+		prefixes: map[string]string{"v4": "crm/v4/associations"},
+		pinned:   true,
+	},
+	RouteAccountInfo: {
+		prefixes: map[string]string{"v3": "account-info/v3"},
+		pinned:   true,
+	},
+	RouteOAuth: {
+		prefixes: map[string]string{"v1": "oauth/v1"},
+		pinned:   true,
+	},
+	RoutePrivateApps: {
+		prefixes: map[string]string{"v2": "oauth/v2/private-apps"},
+		pinned:   true,
+	},
+}
+
+// ValidateAPIVersion returns an error unless every versioned route is
+// implemented for version
+func ValidateAPIVersion(version string) error {
+	var unsupported []string
+	for route, spec := range routeTable {
+		if _, ok := spec.prefixes[version]; !spec.pinned && !ok {
+			unsupported = append(unsupported, string(route))
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+
+	sort.Strings(unsupported)
+	return fmt.Errorf("API version %q is not supported for %s endpoints; supported versions: %s",
+		version, strings.Join(unsupported, ", "), strings.Join(APIVersions, ", "))
+}
+
+// routePath builds the path of route in the version the client uses for it,
+// followed by the given path segments. Pinned routes use their only version,
+// all others the API version of the client, which NewClient validated.
+func (c *Client) routePath(route Route, segments ...string) string {
+	spec := routeTable[route]
+
+	path := spec.prefixes[c.apiVersion]
+	if spec.pinned {
+		for _, prefix := range spec.prefixes {
+			path = prefix
+		}
+	}
+
+	for _, segment := range segments {
+		path += "/" + url.PathEscape(segment)
+	}
+	return path
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRoutePath(t *testing.T) {
+	c := NewClient(Config{APIToken: "pat-test-token"})
+
+	tests := []struct {
+		route    Route
+		segments []string
+		want     string
+	}{
+		{RouteObjects, []string{"contacts", "1"}, "crm/v3/objects/contacts/1"},
+		{RouteProperties, []string{"deals", "a b"}, "crm/v3/properties/deals/a%20b"},
+		{RouteAssociations, []string{"contacts", "companies", "labels"}, "crm/v4/associations/contacts/companies/labels"},
+		{RouteAccountInfo, []string{"details"}, "account-info/v3/details"},
+		{RouteOAuth, []string{"token"}, "oauth/v1/token"},
+		{RoutePrivateApps, []string{"get", "access-token-info"}, "oauth/v2/private-apps/get/access-token-info"},
+	}
+
+	for _, tt := range tests {
+		if got := c.routePath(tt.route, tt.segments...); got != tt.want {
+			t.Errorf("routePath(%s, %v) = %q, want %q", tt.route, tt.segments, got, tt.want)
+		}
+	}
+}
+
+func TestValidateAPIVersion(t *testing.T) {
+	for _, version := range APIVersions {
+		if err := ValidateAPIVersion(version); err != nil {
+			t.Errorf("ValidateAPIVersion(%q) = %v, want nil", version, err)
+		}
+	}
+	if err := ValidateAPIVersion("v4"); err == nil {
+		t.Error("ValidateAPIVersion(\"v4\") = nil, want an error")
+	}
+}
+
+func TestUnsupportedAPIVersionFailsRequests(t *testing.T) {
+	c := NewClient(Config{APIToken: "pat-test-token", APIVersion: "v4", BaseURL: "http://127.0.0.1:0"})
+
+	if _, err := c.GetContact(context.Background(), "1"); err == nil {
+		t.Fatal("expected an error for an unsupported API version")
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ObjectSchema represents a HubSpot custom object schema
+type ObjectSchema struct {
+	ID                         string               `json:"id"`
+	Name                       string               `json:"name"`
+	Labels                     ObjectSchemaLabels   `json:"labels"`
+	ObjectTypeID               string               `json:"objectTypeId"`
+	FullyQualifiedName         string               `json:"fullyQualifiedName"`
+	PrimaryDisplayProperty     string               `json:"primaryDisplayProperty"`
+	RequiredProperties         []string             `json:"requiredProperties"`
+	SearchableProperties       []string             `json:"searchableProperties"`
+	SecondaryDisplayProperties []string             `json:"secondaryDisplayProperties"`
+	Properties                 []PropertyDefinition `json:"properties"`
+	Associations               []SchemaAssociation  `json:"associations"`
+	Archived                   bool                 `json:"archived"`
+	CreatedAt                  time.Time            `json:"createdAt"`
+	UpdatedAt                  time.Time            `json:"updatedAt"`
+}
+
+// ObjectSchemaLabels holds the singular and plural display names of a custom object
+type ObjectSchemaLabels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+}
+
+// SchemaAssociation represents an association definition of a custom object schema
+type SchemaAssociation struct {
+	ID               string `json:"id"`
+	Name             string `json:"name,omitempty"`
+	FromObjectTypeID string `json:"fromObjectTypeId"`
+	ToObjectTypeID   string `json:"toObjectTypeId"`
+}
+
+// ObjectSchemaInput represents the request body for creating a custom object schema
+type ObjectSchemaInput struct {
+	Name                       string               `json:"name"`
+	Labels                     ObjectSchemaLabels   `json:"labels"`
+	PrimaryDisplayProperty     string               `json:"primaryDisplayProperty,omitempty"`
+	RequiredProperties         []string             `json:"requiredProperties"`
+	SearchableProperties       []string             `json:"searchableProperties,omitempty"`
+	SecondaryDisplayProperties []string             `json:"secondaryDisplayProperties,omitempty"`
+	Properties                 []PropertyDefinition `json:"properties"`
+	AssociatedObjects          []string             `json:"associatedObjects"`
+}
+
+// ObjectSchemaUpdate represents the request body for updating a custom
+// object schema. The name, properties and associations of a schema are not
+// part of the update; properties are managed through the properties API.
+type ObjectSchemaUpdate struct {
+	Labels                     ObjectSchemaLabels `json:"labels"`
+	PrimaryDisplayProperty     string             `json:"primaryDisplayProperty,omitempty"`
+	RequiredProperties         []string           `json:"requiredProperties"`
+	SearchableProperties       []string           `json:"searchableProperties"`
+	SecondaryDisplayProperties []string           `json:"secondaryDisplayProperties"`
+}
+
+// schemasPath builds the path for custom object schemas, optionally
+// followed by additional path segments
+func (c *Client) schemasPath(segments ...string) string {
+	return c.routePath(RouteSchemas, segments...)
+}
+
+// CreateObjectSchema creates a new custom object schema
+func (c *Client) CreateObjectSchema(ctx context.Context, schema *ObjectSchemaInput) (*ObjectSchema, error) {
+	resp, err := c.Post(ctx, c.schemasPath(), schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom object schema: %w", err)
+	}
+
+	var created ObjectSchema
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode custom object schema response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetObjectSchema retrieves a custom object schema by object type ID or fully qualified name
+func (c *Client) GetObjectSchema(ctx context.Context, objectType string) (*ObjectSchema, error) {
+	resp, err := c.Get(ctx, c.schemasPath(objectType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom object schema: %w", err)
+	}
+
+	var schema ObjectSchema
+	if err := DecodeResponse(resp, &schema); err != nil {
+		return nil, fmt.Errorf("failed to decode custom object schema response: %w", err)
+	}
+
+	return &schema, nil
+}
+
+// UpdateObjectSchema updates the labels and display settings of a custom object schema
+func (c *Client) UpdateObjectSchema(ctx context.Context, objectType string, update *ObjectSchemaUpdate) (*ObjectSchema, error) {
+	resp, err := c.Patch(ctx, c.schemasPath(objectType), update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update custom object schema: %w", err)
+	}
+
+	var updated ObjectSchema
+	if err := DecodeResponse(resp, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode custom object schema response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteObjectSchema deletes a custom object schema. HubSpot refuses the
+// deletion while records of the object type still exist.
+func (c *Client) DeleteObjectSchema(ctx context.Context, objectType string) error {
+	resp, err := c.Delete(ctx, c.schemasPath(objectType))
+	if err != nil {
+		return fmt.Errorf("failed to delete custom object schema: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// CreateObjectSchemaAssociation defines a new association from a custom object type to another object type
+func (c *Client) CreateObjectSchemaAssociation(ctx context.Context, objectTypeID, toObjectTypeID string) (*SchemaAssociation, error) {
+	reqBody := SchemaAssociation{
+		FromObjectTypeID: objectTypeID,
+		ToObjectTypeID:   toObjectTypeID,
+	}
+
+	resp, err := c.Post(ctx, c.schemasPath(objectTypeID, "associations"), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom object schema association: %w", err)
+	}
+
+	var created SchemaAssociation
+	if err := DecodeResponse(resp, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode custom object schema association response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// DeleteObjectSchemaAssociation removes an association definition from a custom object schema
+func (c *Client) DeleteObjectSchemaAssociation(ctx context.Context, objectType, associationID string) error {
+	resp, err := c.Delete(ctx, c.schemasPath(objectType, "associations", associationID))
+	if err != nil {
+		return fmt.Errorf("failed to delete custom object schema association: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SearchPageSize is the largest number of results HubSpot returns per search page
+const SearchPageSize = 200
+
+// SearchResultCeiling is the largest number of results the HubSpot search
+// API returns for a single query, regardless of paging
+const SearchResultCeiling = 10000
+
+// ErrSearchResultCeiling is returned when a search matches more results
+// than the search API can page through
+var ErrSearchResultCeiling = errors.New("search matches more results than HubSpot can return")
+
+// FilterOperator is a comparison operator of a search filter
+type FilterOperator string
+
+// Search filter operators
+const (
+	OperatorEQ               FilterOperator = "EQ"
+	OperatorNEQ              FilterOperator = "NEQ"
+	OperatorLT               FilterOperator = "LT"
+	OperatorLTE              FilterOperator = "LTE"
+	OperatorGT               FilterOperator = "GT"
+	OperatorGTE              FilterOperator = "GTE"
+	OperatorBetween          FilterOperator = "BETWEEN"
+	OperatorIn               FilterOperator = "IN"
+	OperatorNotIn            FilterOperator = "NOT_IN"
+	OperatorHasProperty      FilterOperator = "HAS_PROPERTY"
+	OperatorNotHasProperty   FilterOperator = "NOT_HAS_PROPERTY"
+	OperatorContainsToken    FilterOperator = "CONTAINS_TOKEN"
+	OperatorNotContainsToken FilterOperator = "NOT_CONTAINS_TOKEN"
+)
+
+// FilterOperators lists all search filter operators
+var FilterOperators = []FilterOperator{
+	OperatorEQ, OperatorNEQ, OperatorLT, OperatorLTE, OperatorGT, OperatorGTE,
+	OperatorBetween, OperatorIn, OperatorNotIn, OperatorHasProperty, OperatorNotHasProperty,
+	OperatorContainsToken, OperatorNotContainsToken,
+}
+
+// SortDirection is the direction search results are sorted in
+type SortDirection string
+
+// Search sort directions
+const (
+	SortAscending  SortDirection = "ASCENDING"
+	SortDescending SortDirection = "DESCENDING"
+)
+
+// SearchRequest represents a search request for CRM objects
+type SearchRequest struct {
+	FilterGroups []FilterGroup `json:"filterGroups"`
+	Sorts        []Sort        `json:"sorts,omitempty"`
+	Properties   []string      `json:"properties,omitempty"`
+	Limit        int           `json:"limit,omitempty"`
+	After        string        `json:"after,omitempty"`
+}
+
+// FilterGroup represents a group of filters, all of which must match.
+// A record matches a search when any of its filter groups matches.
+type FilterGroup struct {
+	Filters []Filter `json:"filters"`
+}
+
+// Filter represents a single filter condition. IN and NOT_IN compare against
+// Values, BETWEEN against Value and HighValue, and HAS_PROPERTY and
+// NOT_HAS_PROPERTY take no value.
+type Filter struct {
+	PropertyName string         `json:"propertyName"`
+	Operator     FilterOperator `json:"operator"`
+	Value        string         `json:"value,omitempty"`
+	Values       []string       `json:"values,omitempty"`
+	HighValue    string         `json:"highValue,omitempty"`
+}
+
+// Sort orders search results by a property
+type Sort struct {
+	PropertyName string        `json:"propertyName"`
+	Direction    SortDirection `json:"direction"`
+}
+
+// SearchResponse represents the response from a CRM object search
+type SearchResponse struct {
+	Results []CRMObject `json:"results"`
+	Total   int         `json:"total"`
+	Paging  *Paging     `json:"paging,omitempty"`
+}
+
+// SearchObjects searches CRM objects of the given type, returning a single page of results
+func (c *Client) SearchObjects(ctx context.Context, objectType string, searchReq SearchRequest) (*SearchResponse, error) {
+	resp, err := c.Post(ctx, c.objectsPath(objectType, "search"), searchReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s objects: %w", objectType, err)
+	}
+
+	var searchResp SearchResponse
+	if err := DecodeResponse(resp, &searchResp); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	return &searchResp, nil
+}
+
+// SearchIterator pages through the results of a CRM object search.
+//
+//	it := c.NewSearchIterator(client.ObjectTypeContacts, req, 0)
+//	for it.Next(ctx) {
+//		object := it.Object()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SearchIterator struct {
+	client     *Client
+	objectType string
+	request    SearchRequest
+	maxResults int
+
+	page    []CRMObject
+	index   int
+	read    int
+	total   int
+	started bool
+	done    bool
+	err     error
+}
+
+// NewSearchIterator returns an iterator over the results of a search.
+// maxResults caps the number of results read; 0 reads all results. The
+// iterator fails with ErrSearchResultCeiling when more results are wanted
+// than the search API can return.
+func (c *Client) NewSearchIterator(objectType string, searchReq SearchRequest, maxResults int) *SearchIterator {
+	return &SearchIterator{
+		client:     c,
+		objectType: objectType,
+		request:    searchReq,
+		maxResults: maxResults,
+	}
+}
+
+// Next advances to the next result, fetching the next page when needed. It
+// returns false when there are no more results or an error occurred.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	if it.err != nil || (it.maxResults > 0 && it.read >= it.maxResults) {
+		return false
+	}
+
+	for it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetch(ctx) {
+			return false
+		}
+	}
+
+	it.index++
+	it.read++
+	return true
+}
+
+// fetch reads the next page of results
+func (it *SearchIterator) fetch(ctx context.Context) bool {
+	it.request.Limit = SearchPageSize
+	if remaining := it.maxResults - it.read; it.maxResults > 0 && remaining < SearchPageSize {
+		it.request.Limit = remaining
+	}
+
+	page, err := it.client.SearchObjects(ctx, it.objectType, it.request)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		it.total = page.Total
+
+		wanted := it.total
+		if it.maxResults > 0 && it.maxResults < wanted {
+			wanted = it.maxResults
+		}
+		if wanted > SearchResultCeiling {
+			it.err = fmt.Errorf("%w: the search matches %d %s objects but at most %d can be read; narrow the filters or cap the number of results",
+				ErrSearchResultCeiling, it.total, it.objectType, SearchResultCeiling)
+			return false
+		}
+	}
+
+	it.page = page.Results
+	it.index = 0
+
+	if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" || len(page.Results) == 0 {
+		it.done = true
+	} else {
+		it.request.After = page.Paging.Next.After
+	}
+
+	return true
+}
+
+// Object returns the current result
+func (it *SearchIterator) Object() *CRMObject {
+	return &it.page[it.index-1]
+}
+
+// Total returns the total number of results matching the search. It is
+// known once Next has been called.
+func (it *SearchIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *SearchIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// searchServer answers contact searches from total numbered records, paging
+// by the requested limit with the offset as the after cursor
+type searchServer struct {
+	*httptest.Server
+
+	total    int
+	requests []SearchRequest
+}
+
+func newSearchServer(t *testing.T, total int) *searchServer {
+	t.Helper()
+
+	s := &searchServer{total: total}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/crm/v3/objects/contacts/search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding search request: %v", err)
+		}
+		s.requests = append(s.requests, req)
+
+		offset := 0
+		if req.After != "" {
+			offset, _ = strconv.Atoi(req.After)
+		}
+		end := offset + req.Limit
+		if end > s.total {
+			end = s.total
+		}
+
+		results := make([]string, 0, end-offset)
+		for i := offset; i < end; i++ {
+			results = append(results, fmt.Sprintf(`{"id":"%d","properties":{}}`, i))
+		}
+		paging := ""
+		if end < s.total {
+			paging = fmt.Sprintf(`,"paging":{"next":{"after":"%d"}}`, end)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"total":%d,"results":[%s]%s}`, s.total, strings.Join(results, ","), paging)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// readAll returns the IDs of the results of the iterator
+func readAll(ctx context.Context, it *SearchIterator) []string {
+	var ids []string
+	for it.Next(ctx) {
+		ids = append(ids, it.Object().ID)
+	}
+	return ids
+}
+
+func TestSearchIteratorPages(t *testing.T) {
+	s := newSearchServer(t, 450)
+	c := newTestClient(s.URL, nil)
+
+	it := c.NewSearchIterator(ObjectTypeContacts, SearchRequest{}, 0)
+	ids := readAll(context.Background(), it)
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 450 || ids[0] != "0" || ids[449] != "449" {
+		t.Errorf("got %d results, want 0 to 449", len(ids))
+	}
+	if it.Total() != 450 {
+		t.Errorf("got total %d, want 450", it.Total())
+	}
+
+	wantAfters := []string{"", "200", "400"}
+	if len(s.requests) != len(wantAfters) {
+		t.Fatalf("got %d requests, want %d", len(s.requests), len(wantAfters))
+	}
+	for i, after := range wantAfters {
+		if s.requests[i].After != after || s.requests[i].Limit != SearchPageSize {
+			t.Errorf("request %d: got after %q limit %d, want after %q limit %d",
+				i, s.requests[i].After, s.requests[i].Limit, after, SearchPageSize)
+		}
+	}
+}
+
+func TestSearchIteratorMaxResults(t *testing.T) {
+	s := newSearchServer(t, 450)
+	c := newTestClient(s.URL, nil)
+
+	it := c.NewSearchIterator(ObjectTypeContacts, SearchRequest{}, 250)
+	ids := readAll(context.Background(), it)
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 250 {
+		t.Errorf("got %d results, want 250", len(ids))
+	}
+
+	// The last page only asks for the remaining results
+	if len(s.requests) != 2 || s.requests[0].Limit != SearchPageSize || s.requests[1].Limit != 50 {
+		t.Errorf("got requests %+v, want limits 200 and 50", s.requests)
+	}
+}
+
+func TestSearchIteratorResultCeiling(t *testing.T) {
+	s := newSearchServer(t, SearchResultCeiling+1)
+	c := newTestClient(s.URL, nil)
+
+	it := c.NewSearchIterator(ObjectTypeContacts, SearchRequest{}, 0)
+	ids := readAll(context.Background(), it)
+
+	if !errors.Is(it.Err(), ErrSearchResultCeiling) {
+		t.Fatalf("got error %v, want ErrSearchResultCeiling", it.Err())
+	}
+	if len(ids) != 0 {
+		t.Errorf("got %d results, want none", len(ids))
+	}
+
+	// A cap within the ceiling reads despite the total
+	capped := c.NewSearchIterator(ObjectTypeContacts, SearchRequest{}, 10)
+	if ids := readAll(context.Background(), capped); capped.Err() != nil || len(ids) != 10 {
+		t.Errorf("got %d results and error %v, want 10 results", len(ids), capped.Err())
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// TokenInfo describes the access token the client authenticates with
+type TokenInfo struct {
+	HubID  int64
+	UserID int64
+	AppID  int64
+	Scopes []string
+}
+
+// privateAppTokenInfo is the introspection response for a private app token
+type privateAppTokenInfo struct {
+	HubID  int64    `json:"hubId"`
+	UserID int64    `json:"userId"`
+	AppID  int64    `json:"appId"`
+	Scopes []string `json:"scopes"`
+}
+
+// oauthTokenInfo is the introspection response for an OAuth access token
+type oauthTokenInfo struct {
+	HubID  int64    `json:"hub_id"`
+	UserID int64    `json:"user_id"`
+	AppID  int64    `json:"app_id"`
+	Scopes []string `json:"scopes"`
+}
+
+// IntrospectToken looks up the portal and scopes of the access token
+func (c *Client) IntrospectToken(ctx context.Context) (*TokenInfo, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect access token: %w", err)
+	}
+
+	if _, ok := c.tokens.(*OAuthTokenSource); ok {
+		resp, err := c.Get(ctx, c.routePath(RouteOAuth, "access-tokens", token))
+		if err != nil {
+			return nil, fmt.Errorf("failed to introspect access token: %w", err)
+		}
+
+		var info oauthTokenInfo
+		if err := DecodeResponse(resp, &info); err != nil {
+			return nil, err
+		}
+		return &TokenInfo{HubID: info.HubID, UserID: info.UserID, AppID: info.AppID, Scopes: info.Scopes}, nil
+	}
+
+	resp, err := c.Post(ctx, c.routePath(RoutePrivateApps, "get", "access-token-info"), map[string]string{"tokenKey": token})
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect access token: %w", err)
+	}
+
+	var info privateAppTokenInfo
+	if err := DecodeResponse(resp, &info); err != nil {
+		return nil, err
+	}
+	return &TokenInfo{HubID: info.HubID, UserID: info.UserID, AppID: info.AppID, Scopes: info.Scopes}, nil
+}
+
+// ValidateCredentials introspects the access token and records its portal
+// and scopes on the client, so MissingScopes can check them
+func (c *Client) ValidateCredentials(ctx context.Context) (*TokenInfo, error) {
+	info, err := c.IntrospectToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.infoMu.Lock()
+	c.tokenInfo = info
+	c.infoMu.Unlock()
+
+	return info, nil
+}
+
+// TokenInfo returns what ValidateCredentials recorded about the access
+// token, or nil if the credentials were not validated
+func (c *Client) TokenInfo() *TokenInfo {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+
+	return c.tokenInfo
+}
+
+// MissingScopes returns the scopes in required that the access token was
+// not granted. Nothing is missing when the credentials were not validated.
+func (c *Client) MissingScopes(required []string) []string {
+	info := c.TokenInfo()
+	if info == nil {
+		return nil
+	}
+
+	granted := make(map[string]bool, len(info.Scopes))
+	for _, scope := range info.Scopes {
+		granted[scope] = true
+	}
+
+	var missing []string
+	for _, scope := range required {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
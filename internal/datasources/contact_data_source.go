@@ -0,0 +1,218 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterDataSource(NewContactDataSource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ContactDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ContactDataSource{}
+
+// NewContactDataSource creates a new contact data source.
+func NewContactDataSource() datasource.DataSource {
+	return &ContactDataSource{}
+}
+
+// ContactDataSource defines the data source implementation.
+type ContactDataSource struct {
+	client *client.Client
+}
+
+// ContactDataSourceModel describes the data source data model.
+type ContactDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Email           types.String `tfsdk:"email"`
+	IDProperty      types.String `tfsdk:"id_property"`
+	IDPropertyValue types.String `tfsdk:"id_property_value"`
+	PropertyNames   types.List   `tfsdk:"property_names"`
+	Properties      types.Map    `tfsdk:"properties"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Archived        types.Bool   `tfsdk:"archived"`
+}
+
+// Metadata returns the data source type name.
+func (d *ContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+// Schema defines the schema for the data source.
+func (d *ContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a HubSpot contact by ID, email address, or any unique-value property.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The record ID of the contact to look up.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the contact to look up.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`),
+						"must be a valid email address",
+					),
+				},
+			},
+			"id_property": schema.StringAttribute{
+				Description: "The name of a unique-value property to look the contact up by. Requires id_property_value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("id_property_value")),
+				},
+			},
+			"id_property_value": schema.StringAttribute{
+				Description: "The value of id_property to look the contact up by.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("id_property")),
+				},
+			},
+			"property_names": schema.ListAttribute{
+				Description: "The properties to return. HubSpot returns a default set of properties when omitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"properties": schema.MapAttribute{
+				Description: "The returned properties of the contact. Properties without a value are omitted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the contact was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the contact was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the contact is archived.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one lookup key.
+func (d *ContactDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("id_property"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, d.client, "hubspot_contact", "crm.objects.contacts.read")
+}
+
+// Read looks up the contact.
+func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ContactDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.GetObjectOptions{}
+	if !data.PropertyNames.IsNull() {
+		resp.Diagnostics.Append(data.PropertyNames.ElementsAs(ctx, &opts.Properties, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	lookup := data.ID.ValueString()
+	description := fmt.Sprintf("ID %s", lookup)
+	switch {
+	case !data.Email.IsNull():
+		lookup = data.Email.ValueString()
+		opts.IDProperty = "email"
+		description = fmt.Sprintf("email %s", lookup)
+	case !data.IDProperty.IsNull():
+		lookup = data.IDPropertyValue.ValueString()
+		opts.IDProperty = data.IDProperty.ValueString()
+		description = fmt.Sprintf("%s %s", opts.IDProperty, lookup)
+	}
+
+	// Get contact from API
+	contact, err := d.client.GetObject(ctx, client.ObjectTypeContacts, lookup, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Contact Not Found",
+				fmt.Sprintf("No contact with %s exists.", description),
+			)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Contact",
+			fmt.Sprintf("Could not read contact with %s", description),
+			err,
+		)
+		return
+	}
+
+	data.ID = types.StringValue(contact.ID)
+	if email, ok := contact.Properties["email"].(string); ok {
+		data.Email = types.StringValue(email)
+	}
+	data.CreatedAt = types.StringValue(contact.CreatedAt.Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(contact.UpdatedAt.Format(time.RFC3339))
+	data.Archived = types.BoolValue(contact.Archived)
+
+	properties, diags := objectPropertiesValue(ctx, contact.Properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Properties = properties
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
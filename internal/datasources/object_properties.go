@@ -0,0 +1,27 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// objectPropertiesValue converts the properties of a CRM object into a map
+// of strings. HubSpot returns unset properties as null; they are omitted.
+func objectPropertiesValue(ctx context.Context, properties map[string]interface{}) (types.Map, diag.Diagnostics) {
+	values := make(map[string]string, len(properties))
+	for key, value := range properties {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			values[key] = v
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
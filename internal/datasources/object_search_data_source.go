@@ -0,0 +1,423 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterDataSource(NewContactsDataSource)
+	registry.RegisterDataSource(NewCompaniesDataSource)
+	registry.RegisterDataSource(NewDealsDataSource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectSearchDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ObjectSearchDataSource{}
+
+// NewContactsDataSource creates a data source that searches contacts.
+func NewContactsDataSource() datasource.DataSource {
+	return &ObjectSearchDataSource{objectType: client.ObjectTypeContacts, typeName: "contacts"}
+}
+
+// NewCompaniesDataSource creates a data source that searches companies.
+func NewCompaniesDataSource() datasource.DataSource {
+	return &ObjectSearchDataSource{objectType: client.ObjectTypeCompanies, typeName: "companies"}
+}
+
+// NewDealsDataSource creates a data source that searches deals.
+func NewDealsDataSource() datasource.DataSource {
+	return &ObjectSearchDataSource{objectType: client.ObjectTypeDeals, typeName: "deals"}
+}
+
+// ObjectSearchDataSource defines a data source that searches CRM objects of
+// a single object type.
+type ObjectSearchDataSource struct {
+	client     *client.Client
+	objectType string
+	typeName   string
+}
+
+// ObjectSearchDataSourceModel describes the data source data model.
+type ObjectSearchDataSourceModel struct {
+	FilterGroups  types.List  `tfsdk:"filter_groups"`
+	Sorts         types.List  `tfsdk:"sorts"`
+	PropertyNames types.List  `tfsdk:"property_names"`
+	Limit         types.Int64 `tfsdk:"limit"`
+	Results       types.List  `tfsdk:"results"`
+	Total         types.Int64 `tfsdk:"total"`
+}
+
+// SearchFilterGroupModel describes a group of filters that must all match.
+type SearchFilterGroupModel struct {
+	Filters []SearchFilterModel `tfsdk:"filters"`
+}
+
+// SearchFilterModel describes a single filter condition.
+type SearchFilterModel struct {
+	PropertyName types.String `tfsdk:"property_name"`
+	Operator     types.String `tfsdk:"operator"`
+	Value        types.String `tfsdk:"value"`
+	Values       types.List   `tfsdk:"values"`
+	HighValue    types.String `tfsdk:"high_value"`
+}
+
+// SearchSortModel describes the ordering of the results by a property.
+type SearchSortModel struct {
+	PropertyName types.String `tfsdk:"property_name"`
+	Direction    types.String `tfsdk:"direction"`
+}
+
+// SearchResultModel describes a single matching CRM object.
+type SearchResultModel struct {
+	ID         types.String `tfsdk:"id"`
+	Properties types.Map    `tfsdk:"properties"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	Archived   types.Bool   `tfsdk:"archived"`
+}
+
+// searchResultAttrTypes are the attribute types of a results list element.
+var searchResultAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"properties": types.MapType{ElemType: types.StringType},
+	"created_at": types.StringType,
+	"updated_at": types.StringType,
+	"archived":   types.BoolType,
+}
+
+// Metadata returns the data source type name.
+func (d *ObjectSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+// Schema defines the schema for the data source.
+func (d *ObjectSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Searches HubSpot %s with the CRM search API.", d.typeName),
+		Attributes: map[string]schema.Attribute{
+			"filter_groups": schema.ListNestedAttribute{
+				Description: "Groups of filters. A record matches when all filters of any one group match. All records match when omitted.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"filters": schema.ListNestedAttribute{
+							Description: "The filters of the group, all of which must match.",
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeBetween(1, 6),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"property_name": schema.StringAttribute{
+										Description: "The property to filter on.",
+										Required:    true,
+									},
+									"operator": schema.StringAttribute{
+										Description: "The filter operator.",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(searchOperators()...),
+										},
+									},
+									"value": schema.StringAttribute{
+										Description: "The value to compare against, and the lower bound for BETWEEN.",
+										Optional:    true,
+									},
+									"values": schema.ListAttribute{
+										Description: "The values to compare against for IN and NOT_IN.",
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									"high_value": schema.StringAttribute{
+										Description: "The upper bound for BETWEEN.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sorts": schema.ListNestedAttribute{
+				Description: "The ordering of the results.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"property_name": schema.StringAttribute{
+							Description: "The property to sort by.",
+							Required:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "ASCENDING (the default) or DESCENDING.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(client.SortAscending), string(client.SortDescending)),
+							},
+						},
+					},
+				},
+			},
+			"property_names": schema.ListAttribute{
+				Description: "The properties to return. HubSpot returns a default set of properties when omitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matching results are returned when omitted; " +
+					"HubSpot returns at most 10,000 results per search, so broader searches must set a limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The matching records.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The record ID.",
+							Computed:    true,
+						},
+						"properties": schema.MapAttribute{
+							Description: "The returned properties of the record. Properties without a value are omitted.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the record was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the record was last updated, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the record is archived.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"total": schema.Int64Attribute{
+				Description: "The total number of matching records, which may exceed the number of results.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that each filter has the values its operator needs.
+func (d *ObjectSearchDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var filterGroups types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter_groups"), &filterGroups)...)
+	if resp.Diagnostics.HasError() || filterGroups.IsNull() || filterGroups.IsUnknown() {
+		return
+	}
+
+	var groups []SearchFilterGroupModel
+	resp.Diagnostics.Append(filterGroups.ElementsAs(ctx, &groups, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, group := range groups {
+		for j, filter := range group.Filters {
+			if filter.Operator.IsUnknown() {
+				continue
+			}
+
+			filterPath := path.Root("filter_groups").AtListIndex(i).AtName("filters").AtListIndex(j)
+			hasValue := !filter.Value.IsNull()
+			hasValues := !filter.Values.IsNull()
+			hasHighValue := !filter.HighValue.IsNull()
+
+			var problem string
+			switch operator := client.FilterOperator(filter.Operator.ValueString()); operator {
+			case client.OperatorIn, client.OperatorNotIn:
+				if !hasValues || hasValue || hasHighValue {
+					problem = fmt.Sprintf("%s requires values and no value or high_value.", operator)
+				}
+			case client.OperatorBetween:
+				if !hasValue || !hasHighValue || hasValues {
+					problem = "BETWEEN requires value and high_value and no values."
+				}
+			case client.OperatorHasProperty, client.OperatorNotHasProperty:
+				if hasValue || hasValues || hasHighValue {
+					problem = fmt.Sprintf("%s takes no value, values or high_value.", operator)
+				}
+			default:
+				if !hasValue || hasValues || hasHighValue {
+					problem = fmt.Sprintf("%s requires value and no values or high_value.", operator)
+				}
+			}
+
+			if problem != "" {
+				resp.Diagnostics.AddAttributeError(filterPath, "Invalid Search Filter", problem)
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ObjectSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, d.client, "hubspot_"+d.typeName, fmt.Sprintf("crm.objects.%s.read", d.objectType))
+}
+
+// Read runs the search, reading all results or up to the configured limit.
+func (d *ObjectSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectSearchDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchReq, diags := searchRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := 0
+	if !data.Limit.IsNull() {
+		maxResults = int(data.Limit.ValueInt64())
+	}
+
+	results := []SearchResultModel{}
+	it := d.client.NewSearchIterator(d.objectType, searchReq, maxResults)
+	for it.Next(ctx) {
+		object := it.Object()
+
+		properties, diags := objectPropertiesValue(ctx, object.Properties)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		results = append(results, SearchResultModel{
+			ID:         types.StringValue(object.ID),
+			Properties: properties,
+			CreatedAt:  types.StringValue(object.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:  types.StringValue(object.UpdatedAt.Format(time.RFC3339)),
+			Archived:   types.BoolValue(object.Archived),
+		})
+	}
+	if err := it.Err(); err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Searching CRM Objects",
+			fmt.Sprintf("Could not search %s", d.typeName),
+			err,
+		)
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: searchResultAttrTypes}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Results = list
+	data.Total = types.Int64Value(int64(it.Total()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// searchRequest builds the HubSpot search request from the data source model.
+func searchRequest(ctx context.Context, data ObjectSearchDataSourceModel) (client.SearchRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	searchReq := client.SearchRequest{
+		FilterGroups: []client.FilterGroup{},
+	}
+
+	if !data.FilterGroups.IsNull() {
+		var groups []SearchFilterGroupModel
+		diags.Append(data.FilterGroups.ElementsAs(ctx, &groups, false)...)
+
+		for _, group := range groups {
+			filterGroup := client.FilterGroup{}
+			for _, filter := range group.Filters {
+				f := client.Filter{
+					PropertyName: filter.PropertyName.ValueString(),
+					Operator:     client.FilterOperator(filter.Operator.ValueString()),
+					Value:        filter.Value.ValueString(),
+					HighValue:    filter.HighValue.ValueString(),
+				}
+				if !filter.Values.IsNull() {
+					diags.Append(filter.Values.ElementsAs(ctx, &f.Values, false)...)
+				}
+				filterGroup.Filters = append(filterGroup.Filters, f)
+			}
+			searchReq.FilterGroups = append(searchReq.FilterGroups, filterGroup)
+		}
+	}
+
+	if !data.Sorts.IsNull() {
+		var sorts []SearchSortModel
+		diags.Append(data.Sorts.ElementsAs(ctx, &sorts, false)...)
+
+		for _, sort := range sorts {
+			direction := client.SortAscending
+			if !sort.Direction.IsNull() {
+				direction = client.SortDirection(sort.Direction.ValueString())
+			}
+			searchReq.Sorts = append(searchReq.Sorts, client.Sort{
+				PropertyName: sort.PropertyName.ValueString(),
+				Direction:    direction,
+			})
+		}
+	}
+
+	if !data.PropertyNames.IsNull() {
+		diags.Append(data.PropertyNames.ElementsAs(ctx, &searchReq.Properties, false)...)
+	}
+
+	return searchReq, diags
+}
+
+// searchOperators returns the names of all search filter operators.
+func searchOperators() []string {
+	operators := make([]string, 0, len(client.FilterOperators))
+	for _, operator := range client.FilterOperators {
+		operators = append(operators, string(operator))
+	}
+	return operators
+}
@@ -0,0 +1,117 @@
+// Package diagnostics turns HubSpot API errors into Terraform diagnostics.
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"terraform-provider-hubspot/internal/client"
+)
+
+// AddError adds an error diagnostic for a failed API call. The detail is
+// followed by the error message and, for errors whose category HubSpot
+// reports, a hint on how to resolve it.
+func AddError(diags *diag.Diagnostics, summary, detail string, err error) {
+	diags.AddError(summary, errorDetail(detail, err))
+}
+
+// AddWarning adds a warning diagnostic for a failed API call the operation
+// can do without, formatted as by AddError.
+func AddWarning(diags *diag.Diagnostics, summary, detail string, err error) {
+	diags.AddWarning(summary, errorDetail(detail, err))
+}
+
+// AddPropertyError adds an error diagnostic for a failed create or update of
+// a CRM object. Properties HubSpot rejects are reported against their
+// attribute: the named top-level attributes when the property is one of
+// them, otherwise the key of the properties map. Errors that do not name a
+// property are added as by AddError.
+func AddPropertyError(diags *diag.Diagnostics, summary, detail string, err error, attributes ...string) {
+	var hubspotErr *client.HubSpotError
+	if !errors.As(err, &hubspotErr) {
+		AddError(diags, summary, detail, err)
+		return
+	}
+
+	names := hubspotErr.InvalidProperties()
+	if len(names) == 0 {
+		AddError(diags, summary, detail, err)
+		return
+	}
+
+	for _, name := range names {
+		diags.AddAttributeError(propertyPath(name, attributes), summary, errorDetail(
+			fmt.Sprintf("%s: HubSpot rejected the value of property %q", detail, name),
+			err,
+		))
+	}
+}
+
+// propertyPath returns the attribute path of a HubSpot property.
+func propertyPath(name string, attributes []string) path.Path {
+	for _, attribute := range attributes {
+		if attribute == name {
+			return path.Root(attribute)
+		}
+	}
+	return path.Root("properties").AtMapKey(name)
+}
+
+// errorDetail formats the detail of a diagnostic for err.
+func errorDetail(detail string, err error) string {
+	message := fmt.Sprintf("%s: %s", detail, err.Error())
+	if hint := hint(err); hint != "" {
+		message += "\n\n" + hint
+	}
+	return message
+}
+
+// hint returns advice on resolving err, or an empty string when there is none.
+func hint(err error) string {
+	switch {
+	case errors.Is(err, client.ErrMissingScopes):
+		var hubspotErr *client.HubSpotError
+		if errors.As(err, &hubspotErr) {
+			if scopes := hubspotErr.RequiredScopes(); len(scopes) > 0 {
+				return fmt.Sprintf("The API token is missing the following required scopes: %s. "+
+					"Grant them to the private app or OAuth app and try again.", strings.Join(scopes, ", "))
+			}
+		}
+		return "The API token is missing a scope required for this operation. " +
+			"Grant it to the private app or OAuth app and try again."
+	case errors.Is(err, client.ErrUnauthorized):
+		return "HubSpot rejected the API token. Check that it is valid and has not expired or been revoked."
+	case errors.Is(err, client.ErrRateLimited), errors.Is(err, client.ErrDailyLimitReached):
+		return "HubSpot rate limits were exceeded. Lower requests_per_ten_seconds in the provider " +
+			"configuration or reduce Terraform parallelism."
+	case errors.Is(err, client.ErrPropertyDoesntExist):
+		return "Check that the property exists on the object type, or create it with the hubspot_property resource."
+	case errors.Is(err, client.ErrConflict):
+		conflict := "HubSpot reported a conflict with existing data"
+		var hubspotErr *client.HubSpotError
+		if errors.As(err, &hubspotErr) && hubspotErr.SubCategory != "" {
+			conflict += fmt.Sprintf(" (%s)", hubspotErr.SubCategory)
+		}
+		return conflict + ". If the object already exists, import it with terraform import to manage it."
+	}
+	return ""
+}
+
+// CheckScopes adds an error listing the scopes in required that the API
+// token was not granted. The scopes of the token are only known, and so only
+// checked, when the provider validated its credentials.
+func CheckScopes(diags *diag.Diagnostics, c *client.Client, typeName string, required ...string) {
+	missing := c.MissingScopes(required)
+	if len(missing) == 0 {
+		return
+	}
+
+	diags.AddError(
+		"Missing Scopes",
+		fmt.Sprintf("%s requires scopes the API token was not granted: %s. "+
+			"Grant them to the private app or OAuth app and try again.", typeName, strings.Join(missing, ", ")),
+	)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+)
+
+func TestCredentialProvider(t *testing.T) {
+	environment := []string{
+		"HUBSPOT_API_TOKEN", "HUBSPOT_API_TOKEN_FILE", "HUBSPOT_CREDENTIAL_PROCESS",
+		"HUBSPOT_PROFILE", "HUBSPOT_CREDENTIALS_FILE",
+	}
+
+	tests := []struct {
+		name   string
+		config HubSpotProviderModel
+		env    map[string]string
+		// defaultFile creates ~/.hubspot/credentials
+		defaultFile bool
+		want        client.CredentialProvider
+	}{
+		{
+			name: "nothing configured",
+			want: nil,
+		},
+		{
+			name:   "api_token before other attributes",
+			config: HubSpotProviderModel{APIToken: types.StringValue("pat-config"), APITokenFile: types.StringValue("/token"), Profile: types.StringValue("sandbox")},
+			want:   client.StaticCredentialProvider("pat-config"),
+		},
+		{
+			name:   "api_token_file before credential_process",
+			config: HubSpotProviderModel{APITokenFile: types.StringValue("/token"), CredentialProcess: types.StringValue("vault read")},
+			want:   client.FileCredentialProvider{Path: "/token"},
+		},
+		{
+			name:   "credential_process before profile",
+			config: HubSpotProviderModel{CredentialProcess: types.StringValue("vault read"), Profile: types.StringValue("sandbox")},
+			want:   client.CommandCredentialProvider{Command: "vault read"},
+		},
+		{
+			name:   "profile with credentials file from the environment",
+			config: HubSpotProviderModel{Profile: types.StringValue("sandbox")},
+			env:    map[string]string{"HUBSPOT_CREDENTIALS_FILE": "/credentials"},
+			want:   client.ProfileCredentialProvider{Path: "/credentials", Profile: "sandbox"},
+		},
+		{
+			name:   "attributes before the environment",
+			config: HubSpotProviderModel{APITokenFile: types.StringValue("/token")},
+			env:    map[string]string{"HUBSPOT_API_TOKEN": "pat-env"},
+			want:   client.FileCredentialProvider{Path: "/token"},
+		},
+		{
+			name: "HUBSPOT_API_TOKEN before other variables",
+			env:  map[string]string{"HUBSPOT_API_TOKEN": "pat-env", "HUBSPOT_API_TOKEN_FILE": "/token"},
+			want: client.StaticCredentialProvider("pat-env"),
+		},
+		{
+			name: "HUBSPOT_API_TOKEN_FILE before HUBSPOT_CREDENTIAL_PROCESS",
+			env:  map[string]string{"HUBSPOT_API_TOKEN_FILE": "/token", "HUBSPOT_CREDENTIAL_PROCESS": "vault read"},
+			want: client.FileCredentialProvider{Path: "/token"},
+		},
+		{
+			name: "HUBSPOT_CREDENTIAL_PROCESS before HUBSPOT_PROFILE",
+			env:  map[string]string{"HUBSPOT_CREDENTIAL_PROCESS": "vault read", "HUBSPOT_PROFILE": "sandbox"},
+			want: client.CommandCredentialProvider{Command: "vault read"},
+		},
+		{
+			name: "HUBSPOT_PROFILE",
+			env:  map[string]string{"HUBSPOT_PROFILE": "sandbox"},
+			want: client.ProfileCredentialProvider{Profile: "sandbox"},
+		},
+		{
+			name:        "default credentials file",
+			defaultFile: true,
+			want:        client.ProfileCredentialProvider{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			for _, name := range environment {
+				t.Setenv(name, "")
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			if tt.defaultFile {
+				dir := filepath.Join(home, ".hubspot")
+				if err := os.MkdirAll(dir, 0o700); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, "credentials"), []byte("[default]\napi_token = pat-file\n"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := credentialProvider(tt.config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
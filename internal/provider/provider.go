@@ -2,15 +2,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+
+	// Resource and data source packages register themselves with the registry on import.
+	_ "terraform-provider-hubspot/internal/datasources"
+	_ "terraform-provider-hubspot/internal/resources"
 )
 
 // Ensure HubSpotProvider satisfies various provider interfaces.
@@ -26,9 +38,26 @@ type HubSpotProvider struct {
 
 // HubSpotProviderModel describes the provider data model.
 type HubSpotProviderModel struct {
-	APIToken   types.String `tfsdk:"api_token"`
-	APIURL     types.String `tfsdk:"api_url"`
-	APIVersion types.String `tfsdk:"api_version"`
+	APIToken              types.String `tfsdk:"api_token"`
+	APITokenFile          types.String `tfsdk:"api_token_file"`
+	CredentialProcess     types.String `tfsdk:"credential_process"`
+	Profile               types.String `tfsdk:"profile"`
+	CredentialsFile       types.String `tfsdk:"credentials_file"`
+	APIURL                types.String `tfsdk:"api_url"`
+	APIVersion            types.String `tfsdk:"api_version"`
+	RequestsPerTenSeconds types.Int64  `tfsdk:"requests_per_ten_seconds"`
+	DailyRequestLimit     types.Int64  `tfsdk:"daily_request_limit"`
+	ValidateCredentials   types.Bool   `tfsdk:"validate_credentials"`
+	ExpectedPortalID      types.Int64  `tfsdk:"expected_portal_id"`
+	OAuth                 *OAuthModel  `tfsdk:"oauth"`
+}
+
+// OAuthModel describes the oauth block of the provider.
+type OAuthModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	TokenURL     types.String `tfsdk:"token_url"`
 }
 
 // New creates a new provider instance.
@@ -52,17 +81,114 @@ func (p *HubSpotProvider) Schema(ctx context.Context, req provider.SchemaRequest
 		Description: "Terraform provider for managing HubSpot resources.",
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
-				Description: "HubSpot API token for authentication. Can also be set via HUBSPOT_API_TOKEN environment variable.",
+				Description: "HubSpot API token for authentication. Can also be set via HUBSPOT_API_TOKEN environment variable. Not used when the oauth block is set.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_token_file": schema.StringAttribute{
+				Description: "Path of a file containing the HubSpot API token. Can also be set via HUBSPOT_API_TOKEN_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token")),
+				},
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "Command that prints the HubSpot API token as JSON, e.g. {\"token\": \"pat-...\", \"expires_at\": \"2024-01-01T00:00:00Z\"}. " +
+					"The command is run again when the token expires or is rejected. Can also be set via HUBSPOT_CREDENTIAL_PROCESS environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("api_token_file")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile in credentials_file to read the API token from. Can also be set via HUBSPOT_PROFILE environment variable. " +
+					"The default profile is used when no other credentials are configured and the credentials file exists.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("api_token_file"), path.MatchRoot("credential_process")),
+				},
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path of the credentials file holding profiles. Defaults to ~/.hubspot/credentials. Can also be set via HUBSPOT_CREDENTIALS_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("api_token_file"), path.MatchRoot("credential_process")),
+				},
+			},
 			"api_url": schema.StringAttribute{
 				Description: "HubSpot API base URL. Defaults to https://api.hubapi.com",
 				Optional:    true,
 			},
 			"api_version": schema.StringAttribute{
-				Description: "HubSpot API version to use. Defaults to v3",
+				Description: "Reserved for selecting the HubSpot API version of the CRM object, property, pipeline and schema " +
+					"endpoints. The provider only implements v3, so this can only be set to v3, which is also the default. " +
+					"Associations always use v4.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.APIVersions...),
+				},
+			},
+			"requests_per_ten_seconds": schema.Int64Attribute{
+				Description: "Maximum number of API requests per 10 seconds. Defaults to the burst limit HubSpot reports for the account.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"daily_request_limit": schema.Int64Attribute{
+				Description: "Maximum number of API requests per day. Defaults to the daily limit HubSpot reports for the account.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Check the API token with HubSpot when the provider is configured, and fail early if it is invalid " +
+					"or lacks scopes required by the resource and data source types used in the configuration. Defaults to false.",
+				Optional: true,
+			},
+			"expected_portal_id": schema.Int64Attribute{
+				Description: "ID of the HubSpot portal the credentials must belong to. Configuration fails if they belong to another portal, " +
+					"guarding against applying a configuration to the wrong portal.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oauth": schema.SingleNestedBlock{
+				Description: "Authenticate as an installation of a HubSpot public app instead of with api_token. " +
+					"Access tokens are obtained with the refresh token and refreshed automatically.",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Description: "The client ID of the public app. Can also be set via HUBSPOT_CLIENT_ID environment variable.",
+						Optional:    true,
+					},
+					"client_secret": schema.StringAttribute{
+						Description: "The client secret of the public app. Can also be set via HUBSPOT_CLIENT_SECRET environment variable.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"refresh_token": schema.StringAttribute{
+						Description: "The refresh token of the app installation. Can also be set via HUBSPOT_REFRESH_TOKEN environment variable.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"token_url": schema.StringAttribute{
+						Description: "The OAuth token endpoint. Defaults to /oauth/v1/token on api_url.",
+						Optional:    true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("api_token"),
+						path.MatchRoot("api_token_file"),
+						path.MatchRoot("credential_process"),
+						path.MatchRoot("profile"),
+						path.MatchRoot("credentials_file"),
+					),
+				},
 			},
 		},
 	}
@@ -77,21 +203,164 @@ func (p *HubSpotProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Get API token from config or environment variable
-	apiToken := config.APIToken.ValueString()
-	if apiToken == "" {
-		apiToken = os.Getenv("HUBSPOT_API_TOKEN")
+	var tokens client.TokenSource
+	var oauth *client.OAuthConfig
+	if config.OAuth != nil {
+		oauth = oauthConfig(config.OAuth, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		tokens = tokenSource(ctx, config, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Get API URL with default
+	apiURL := config.APIURL.ValueString()
+	if apiURL == "" {
+		apiURL = "https://api.hubapi.com"
+	}
+
+	// Get API version with default
+	apiVersion := config.APIVersion.ValueString()
+	if apiVersion == "" {
+		apiVersion = client.DefaultAPIVersion
+	}
+
+	// Create HubSpot client
+	hubspotClient := client.NewClient(client.Config{
+		BaseURL:    apiURL,
+		APIVersion: apiVersion,
+		RateLimit: client.RateLimitConfig{
+			RequestsPerInterval: int(config.RequestsPerTenSeconds.ValueInt64()),
+			DailyLimit:          int(config.DailyRequestLimit.ValueInt64()),
+		},
+		OAuth:            oauth,
+		TokenSource:      tokens,
+		ExpectedPortalID: config.ExpectedPortalID.ValueInt64(),
+	})
+
+	// Check the token up front, so resources and data sources can check
+	// the scopes they need when they are configured
+	if config.ValidateCredentials.ValueBool() {
+		if _, err := hubspotClient.ValidateCredentials(ctx); err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Invalid Credentials",
+				"Could not validate the API token with HubSpot",
+				err,
+			)
+			return
+		}
+	}
+
+	// Refuse to configure the provider for the wrong portal
+	if !config.ExpectedPortalID.IsNull() {
+		portalID, err := hubspotClient.PortalID(ctx)
+		if err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Reading Portal",
+				"Could not determine the HubSpot portal the provider is authenticated with",
+				err,
+			)
+			return
+		}
+
+		if portalID != config.ExpectedPortalID.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_portal_id"),
+				"Portal Mismatch",
+				fmt.Sprintf("The provider is authenticated with portal %d, but expected_portal_id is %d. "+
+					"Check that the credentials belong to the intended portal.", portalID, config.ExpectedPortalID.ValueInt64()),
+			)
+			return
+		}
+	}
+
+	// Make the client available to resources and data sources
+	resp.DataSourceData = hubspotClient
+	resp.ResourceData = hubspotClient
+}
+
+// credentialProvider returns the source of the API token configured in the
+// provider or, failing that, in the environment. It returns nil when no
+// source is configured.
+func credentialProvider(config HubSpotProviderModel) client.CredentialProvider {
+	// Get a setting from config or environment variable
+	setting := func(value types.String, env string) string {
+		if value.ValueString() != "" {
+			return value.ValueString()
+		}
+		return os.Getenv(env)
+	}
+	profile := func(name string) client.CredentialProvider {
+		return client.ProfileCredentialProvider{
+			Path:    setting(config.CredentialsFile, "HUBSPOT_CREDENTIALS_FILE"),
+			Profile: name,
+		}
+	}
+
+	// Attributes set in the configuration take precedence over the environment
+	switch {
+	case config.APIToken.ValueString() != "":
+		return client.StaticCredentialProvider(config.APIToken.ValueString())
+	case config.APITokenFile.ValueString() != "":
+		return client.FileCredentialProvider{Path: config.APITokenFile.ValueString()}
+	case config.CredentialProcess.ValueString() != "":
+		return client.CommandCredentialProvider{Command: config.CredentialProcess.ValueString()}
+	case config.Profile.ValueString() != "" || config.CredentialsFile.ValueString() != "":
+		return profile(setting(config.Profile, "HUBSPOT_PROFILE"))
+	}
+
+	switch {
+	case os.Getenv("HUBSPOT_API_TOKEN") != "":
+		return client.StaticCredentialProvider(os.Getenv("HUBSPOT_API_TOKEN"))
+	case os.Getenv("HUBSPOT_API_TOKEN_FILE") != "":
+		return client.FileCredentialProvider{Path: os.Getenv("HUBSPOT_API_TOKEN_FILE")}
+	case os.Getenv("HUBSPOT_CREDENTIAL_PROCESS") != "":
+		return client.CommandCredentialProvider{Command: os.Getenv("HUBSPOT_CREDENTIAL_PROCESS")}
+	case os.Getenv("HUBSPOT_PROFILE") != "" || os.Getenv("HUBSPOT_CREDENTIALS_FILE") != "":
+		return profile(os.Getenv("HUBSPOT_PROFILE"))
+	}
+
+	// Fall back to the default profile if the credentials file exists
+	if file, err := client.DefaultCredentialsFile(); err == nil {
+		if _, err := os.Stat(file); err == nil {
+			return profile("")
+		}
 	}
 
+	return nil
+}
+
+// tokenSource returns the source of the API token and checks that it
+// provides a plausible token.
+func tokenSource(ctx context.Context, config HubSpotProviderModel, resp *provider.ConfigureResponse) client.TokenSource {
+	credentials := credentialProvider(config)
+
 	// Validate API token is provided
-	if apiToken == "" {
+	if credentials == nil {
 		resp.Diagnostics.AddError(
 			"Missing API Token",
 			"The provider requires an API token for authentication. "+
-				"Set the api_token attribute in the provider configuration or "+
-				"set the HUBSPOT_API_TOKEN environment variable.",
+				"Set the api_token, api_token_file, credential_process or profile attribute in the provider configuration, "+
+				"set the matching HUBSPOT_API_TOKEN, HUBSPOT_API_TOKEN_FILE, HUBSPOT_CREDENTIAL_PROCESS or HUBSPOT_PROFILE "+
+				"environment variable, or add a default profile to ~/.hubspot/credentials.",
 		)
-		return
+		return nil
+	}
+
+	tokens := client.NewCredentialTokenSource(credentials)
+	apiToken, err := tokens.Token(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Token",
+			fmt.Sprintf("Could not read the API token: %s", err.Error()),
+		)
+		return nil
 	}
 
 	// Validate API token format (basic validation - should not be empty or just whitespace)
@@ -100,7 +369,7 @@ func (p *HubSpotProvider) Configure(ctx context.Context, req provider.ConfigureR
 			"Invalid API Token",
 			"The API token cannot be empty or contain only whitespace.",
 		)
-		return
+		return nil
 	}
 
 	// Validate API token format (HubSpot tokens typically start with "pat-" for private app tokens)
@@ -110,43 +379,56 @@ func (p *HubSpotProvider) Configure(ctx context.Context, req provider.ConfigureR
 			"Invalid API Token Format",
 			"The API token appears to be too short. HubSpot API tokens are typically longer than 10 characters.",
 		)
-		return
+		return nil
 	}
 
-	// Get API URL with default
-	apiURL := config.APIURL.ValueString()
-	if apiURL == "" {
-		apiURL = "https://api.hubapi.com"
-	}
+	return tokens
+}
 
-	// Get API version with default
-	apiVersion := config.APIVersion.ValueString()
-	if apiVersion == "" {
-		apiVersion = "v3"
+// oauthConfig returns the OAuth credentials from the oauth block, falling
+// back to the environment for unset attributes.
+func oauthConfig(config *OAuthModel, resp *provider.ConfigureResponse) *client.OAuthConfig {
+	oauth := &client.OAuthConfig{
+		ClientID:     config.ClientID.ValueString(),
+		ClientSecret: config.ClientSecret.ValueString(),
+		RefreshToken: config.RefreshToken.ValueString(),
+		TokenURL:     config.TokenURL.ValueString(),
+	}
+	if oauth.ClientID == "" {
+		oauth.ClientID = os.Getenv("HUBSPOT_CLIENT_ID")
+	}
+	if oauth.ClientSecret == "" {
+		oauth.ClientSecret = os.Getenv("HUBSPOT_CLIENT_SECRET")
+	}
+	if oauth.RefreshToken == "" {
+		oauth.RefreshToken = os.Getenv("HUBSPOT_REFRESH_TOKEN")
 	}
 
-	// Create HubSpot client
-	hubspotClient := client.NewClient(client.Config{
-		APIToken:   apiToken,
-		BaseURL:    apiURL,
-		APIVersion: apiVersion,
-	})
+	values := map[string]string{
+		"client_id":     oauth.ClientID,
+		"client_secret": oauth.ClientSecret,
+		"refresh_token": oauth.RefreshToken,
+	}
+	for _, attribute := range []string{"client_id", "client_secret", "refresh_token"} {
+		if strings.TrimSpace(values[attribute]) == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth").AtName(attribute),
+				"Missing OAuth Credentials",
+				fmt.Sprintf("The oauth block requires %s. Set it in the provider configuration or "+
+					"set the HUBSPOT_%s environment variable.", attribute, strings.ToUpper(attribute)),
+			)
+		}
+	}
 
-	// Make the client available to resources and data sources
-	resp.DataSourceData = hubspotClient
-	resp.ResourceData = hubspotClient
+	return oauth
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *HubSpotProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
-		// Resources will be registered in task 19
-	}
+	return registry.Resources()
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *HubSpotProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
-		// Data sources will be registered in task 19
-	}
+	return registry.DataSources()
 }
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func TestRegisteredResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	seen := map[string]bool{}
+
+	constructors := registry.Resources()
+	if len(constructors) == 0 {
+		t.Fatal("no resources registered")
+	}
+
+	for _, newResource := range constructors {
+		r := newResource()
+
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hubspot"}, metadata)
+		if metadata.TypeName == "" || metadata.TypeName == "hubspot_" {
+			t.Errorf("resource %T has an empty type name", r)
+			continue
+		}
+		if seen[metadata.TypeName] {
+			t.Errorf("resource type %s is registered more than once", metadata.TypeName)
+		}
+		seen[metadata.TypeName] = true
+
+		schema := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schema)
+		if schema.Diagnostics.HasError() {
+			t.Errorf("resource %s: schema diagnostics: %v", metadata.TypeName, schema.Diagnostics)
+		}
+	}
+}
+
+func TestRegisteredDataSourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	seen := map[string]bool{}
+
+	constructors := registry.DataSources()
+	if len(constructors) == 0 {
+		t.Fatal("no data sources registered")
+	}
+
+	for _, newDataSource := range constructors {
+		d := newDataSource()
+
+		metadata := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "hubspot"}, metadata)
+		if metadata.TypeName == "" || metadata.TypeName == "hubspot_" {
+			t.Errorf("data source %T has an empty type name", d)
+			continue
+		}
+		if seen[metadata.TypeName] {
+			t.Errorf("data source type %s is registered more than once", metadata.TypeName)
+		}
+		seen[metadata.TypeName] = true
+
+		schema := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, schema)
+		if schema.Diagnostics.HasError() {
+			t.Errorf("data source %s: schema diagnostics: %v", metadata.TypeName, schema.Diagnostics)
+		}
+	}
+}
+
+func TestProviderSchema(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %v", err)
+	}
+
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("provider schema: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+
+	if len(resp.ResourceSchemas) != len(registry.Resources()) {
+		t.Errorf("got %d resource schemas, want %d", len(resp.ResourceSchemas), len(registry.Resources()))
+	}
+	if len(resp.DataSourceSchemas) != len(registry.DataSources()) {
+		t.Errorf("got %d data source schemas, want %d", len(resp.DataSourceSchemas), len(registry.DataSources()))
+	}
+}
@@ -0,0 +1,50 @@
+// Package registry collects the resources and data sources exposed by the
+// HubSpot provider. Resource and data source packages register their
+// constructors from an init function, and the provider returns whatever has
+// been registered, so adding a new type never requires touching provider.go.
+package registry
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	mu          sync.RWMutex
+	resources   []func() resource.Resource
+	dataSources []func() datasource.DataSource
+)
+
+// RegisterResource adds a resource constructor to the registry.
+func RegisterResource(fn func() resource.Resource) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	resources = append(resources, fn)
+}
+
+// RegisterDataSource adds a data source constructor to the registry.
+func RegisterDataSource(fn func() datasource.DataSource) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	dataSources = append(dataSources, fn)
+}
+
+// Resources returns the registered resource constructors.
+func Resources() []func() resource.Resource {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return append([]func() resource.Resource(nil), resources...)
+}
+
+// DataSources returns the registered data source constructors.
+func DataSources() []func() datasource.DataSource {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return append([]func() datasource.DataSource(nil), dataSources...)
+}
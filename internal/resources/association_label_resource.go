@@ -0,0 +1,402 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewAssociationLabelResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AssociationLabelResource{}
+var _ resource.ResourceWithImportState = &AssociationLabelResource{}
+var _ resource.ResourceWithModifyPlan = &AssociationLabelResource{}
+
+// NewAssociationLabelResource creates a new association label resource.
+func NewAssociationLabelResource() resource.Resource {
+	return &AssociationLabelResource{}
+}
+
+// AssociationLabelResource defines the resource implementation.
+type AssociationLabelResource struct {
+	client *client.Client
+}
+
+// AssociationLabelResourceModel describes the resource data model.
+type AssociationLabelResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	FromObjectType types.String `tfsdk:"from_object_type"`
+	ToObjectType   types.String `tfsdk:"to_object_type"`
+	Name           types.String `tfsdk:"name"`
+	Label          types.String `tfsdk:"label"`
+	InverseLabel   types.String `tfsdk:"inverse_label"`
+	Category       types.String `tfsdk:"category"`
+	TypeID         types.Int64  `tfsdk:"type_id"`
+	InverseTypeID  types.Int64  `tfsdk:"inverse_type_id"`
+	PortalID       types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *AssociationLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_association_label"
+}
+
+// Schema defines the schema for the resource.
+func (r *AssociationLabelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom association label between two HubSpot object types.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the association label, in the format from_object_type/to_object_type/type_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"from_object_type": schema.StringAttribute{
+				Description: "The object type the association starts from (e.g. contacts). Changing this forces a new label.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_object_type": schema.StringAttribute{
+				Description: "The object type the association points to (e.g. companies). Changing this forces a new label.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The internal name of the association label. Changing this forces a new label. " +
+					"HubSpot does not return the name, so it is not refreshed or compared after import.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Replace the label when a previously known name changes.",
+						"Replace the label when a previously known name changes.",
+					),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The label shown for associations from from_object_type to to_object_type (e.g. \"Billing contact\").",
+				Required:    true,
+			},
+			"inverse_label": schema.StringAttribute{
+				Description: "The label shown for the reverse direction, making this a paired label. " +
+					"Adding or removing an inverse label forces a new label.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+						},
+						"Replace the label when an inverse label is added or removed.",
+						"Replace the label when an inverse label is added or removed.",
+					),
+				},
+			},
+			"category": schema.StringAttribute{
+				Description: "The category of the generated association type.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type_id": schema.Int64Attribute{
+				Description: "The generated association type ID, for use as association_type_id of hubspot_association.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"inverse_type_id": schema.Int64Attribute{
+				Description: "The generated association type ID of the reverse direction, when inverse_label is set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AssociationLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage association labels between
+// the planned object types.
+func (r *AssociationLabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_association_label", req, &resp.Diagnostics, objectScopes, "from_object_type", "to_object_type")
+}
+
+// Create creates a new association label resource.
+func (r *AssociationLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AssociationLabelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	input := &client.AssociationLabelInput{
+		Label:        data.Label.ValueString(),
+		Name:         data.Name.ValueString(),
+		InverseLabel: data.InverseLabel.ValueString(),
+	}
+
+	// Create association label via API
+	created, err := r.client.CreateAssociationLabel(ctx, data.FromObjectType.ValueString(), data.ToObjectType.ValueString(), input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Association Label",
+			fmt.Sprintf("Could not create association label %q", input.Label),
+			err,
+		)
+		return
+	}
+	if len(created) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Creating Association Label",
+			fmt.Sprintf("HubSpot did not return an association type for label %q.", input.Label),
+		)
+		return
+	}
+
+	// HubSpot returns one association type per direction; pick each by its label
+	forward, inverse := created[0], (*client.AssociationType)(nil)
+	for i := range created {
+		if created[i].Label == input.Label {
+			forward = created[i]
+			break
+		}
+	}
+	if input.InverseLabel != "" {
+		for i := range created {
+			if created[i].TypeID != forward.TypeID {
+				inverse = &created[i]
+				break
+			}
+		}
+	}
+
+	// Set the ID and generated association types
+	data.Category = types.StringValue(forward.Category)
+	data.TypeID = types.Int64Value(int64(forward.TypeID))
+	data.InverseTypeID = types.Int64Null()
+	if inverse != nil {
+		data.InverseTypeID = types.Int64Value(int64(inverse.TypeID))
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", data.FromObjectType.ValueString(), data.ToObjectType.ValueString(), forward.TypeID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the association label resource.
+func (r *AssociationLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssociationLabelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// List the association types between the two object types
+	definitions, err := r.client.ListAssociationLabels(ctx, data.FromObjectType.ValueString(), data.ToObjectType.ValueString())
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Association Label",
+			fmt.Sprintf("Could not read association label %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	found := false
+	for _, definition := range definitions {
+		if int64(definition.TypeID) == data.TypeID.ValueInt64() {
+			// Update model with API response
+			data.Category = types.StringValue(definition.Category)
+			data.Label = types.StringValue(definition.Label)
+			found = true
+			break
+		}
+	}
+	if !found {
+		// Association label no longer exists, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Refresh the inverse label from the reverse direction
+	if !data.InverseTypeID.IsNull() {
+		inverseDefinitions, err := r.client.ListAssociationLabels(ctx, data.ToObjectType.ValueString(), data.FromObjectType.ValueString())
+		if err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Reading Association Label",
+				fmt.Sprintf("Could not read the inverse of association label %s", data.ID.ValueString()),
+				err,
+			)
+			return
+		}
+
+		for _, definition := range inverseDefinitions {
+			if int64(definition.TypeID) == data.InverseTypeID.ValueInt64() {
+				data.InverseLabel = types.StringValue(definition.Label)
+				break
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the association label resource.
+func (r *AssociationLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssociationLabelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	input := &client.AssociationLabelInput{
+		Label:             data.Label.ValueString(),
+		InverseLabel:      data.InverseLabel.ValueString(),
+		AssociationTypeID: int(data.TypeID.ValueInt64()),
+	}
+
+	// Update association label via API
+	err := r.client.UpdateAssociationLabel(ctx, data.FromObjectType.ValueString(), data.ToObjectType.ValueString(), input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Association Label",
+			fmt.Sprintf("Could not update association label %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the association label resource.
+func (r *AssociationLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssociationLabelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete association label via API
+	err := r.client.DeleteAssociationLabel(ctx, data.FromObjectType.ValueString(), data.ToObjectType.ValueString(), int(data.TypeID.ValueInt64()))
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Association Label",
+			fmt.Sprintf("Could not delete association label %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing association label resource by
+// from_object_type/to_object_type/type_id. Paired labels are imported by
+// from_object_type/to_object_type/type_id/inverse_type_id, so the inverse
+// label is read along with the label instead of forcing a replacement.
+func (r *AssociationLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	format := "from_object_type/to_object_type/type_id"
+	if strings.Count(req.ID, "/") == 3 {
+		format += "/inverse_type_id"
+	}
+
+	parts, err := parseImportID(req.ID, format)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	typeID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("type_id must be a number, got %q", parts[2]))
+		return
+	}
+
+	if len(parts) == 4 {
+		inverseTypeID, err := strconv.ParseInt(parts[3], 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("inverse_type_id must be a number, got %q", parts[3]))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("inverse_type_id"), inverseTypeID)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s/%d", parts[0], parts[1], typeID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("to_object_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type_id"), typeID)...)
+}
@@ -0,0 +1,404 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewAssociationResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AssociationResource{}
+var _ resource.ResourceWithImportState = &AssociationResource{}
+var _ resource.ResourceWithModifyPlan = &AssociationResource{}
+var _ resource.ResourceWithConfigValidators = &AssociationResource{}
+
+// NewAssociationResource creates a new association resource.
+func NewAssociationResource() resource.Resource {
+	return &AssociationResource{}
+}
+
+// AssociationResource defines the resource implementation.
+type AssociationResource struct {
+	client *client.Client
+}
+
+// AssociationResourceModel describes the resource data model.
+type AssociationResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	FromObjectType      types.String `tfsdk:"from_object_type"`
+	FromID              types.String `tfsdk:"from_id"`
+	ToObjectType        types.String `tfsdk:"to_object_type"`
+	ToID                types.String `tfsdk:"to_id"`
+	AssociationCategory types.String `tfsdk:"association_category"`
+	AssociationTypeID   types.Int64  `tfsdk:"association_type_id"`
+	Label               types.String `tfsdk:"label"`
+	PortalID            types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *AssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_association"
+}
+
+// Schema defines the schema for the resource.
+func (r *AssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an association between two HubSpot CRM records. Every attribute change forces a new association.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the association, in the format " +
+					"from_object_type/from_id/to_object_type/to_id/association_category/association_type_id.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"from_object_type": schema.StringAttribute{
+				Description: "The object type of the source record (e.g. contacts).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_id": schema.StringAttribute{
+				Description: "The ID of the source record.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_object_type": schema.StringAttribute{
+				Description: "The object type of the target record (e.g. companies).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_id": schema.StringAttribute{
+				Description: "The ID of the target record.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"association_category": schema.StringAttribute{
+				Description: "The category of the association type. Looked up from the association type or label when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						client.AssociationCategoryHubSpotDefined,
+						client.AssociationCategoryUserDefined,
+						client.AssociationCategoryIntegratorDefined,
+					),
+				},
+			},
+			"association_type_id": schema.Int64Attribute{
+				Description: "The ID of the association type, such as the type_id of a hubspot_association_label. Looked up from label when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The label of the association type (e.g. \"Billing contact\"). Used to look up association_type_id when that is not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the association type to be identified by ID or label.
+func (r *AssociationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("association_type_id"),
+			path.MatchRoot("label"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage associations between
+// the planned object types.
+func (r *AssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_association", req, &resp.Diagnostics, objectScopes, "from_object_type", "to_object_type")
+}
+
+// Create creates a new association resource.
+func (r *AssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Resolve the association type from the definitions between the two object types
+	associationType, err := r.resolveAssociationType(ctx, data)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Resolving Association Type",
+			fmt.Sprintf("Could not resolve the %s to %s association type",
+				data.FromObjectType.ValueString(), data.ToObjectType.ValueString()),
+			err,
+		)
+		return
+	}
+
+	spec := client.AssociationSpec{
+		AssociationCategory: associationType.Category,
+		AssociationTypeID:   associationType.TypeID,
+	}
+
+	// Create association via API
+	err = r.client.CreateAssociation(ctx, data.FromObjectType.ValueString(), data.FromID.ValueString(),
+		data.ToObjectType.ValueString(), data.ToID.ValueString(), []client.AssociationSpec{spec})
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Association",
+			"Could not create association",
+			err,
+		)
+		return
+	}
+
+	// Set the ID and resolved association type
+	data.setAssociationType(associationType)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the association resource.
+func (r *AssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// List the associations of the source record with the target object type
+	associated, err := r.client.ListAssociations(ctx, data.FromObjectType.ValueString(), data.FromID.ValueString(), data.ToObjectType.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Source record no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Association",
+			fmt.Sprintf("Could not read association %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	for _, record := range associated {
+		if strconv.FormatInt(record.ToObjectID, 10) != data.ToID.ValueString() {
+			continue
+		}
+
+		for _, associationType := range record.AssociationTypes {
+			if associationType.Category == data.AssociationCategory.ValueString() &&
+				int64(associationType.TypeID) == data.AssociationTypeID.ValueInt64() {
+				// Update model with API response
+				data.setAssociationType(associationType)
+
+				// Save updated data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				return
+			}
+		}
+	}
+
+	// Association no longer exists, remove from state
+	resp.State.RemoveResource(ctx)
+}
+
+// Update updates the association resource. Every configurable attribute
+// forces replacement, so only the planned values need to be saved.
+func (r *AssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the association resource.
+func (r *AssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	spec := client.AssociationSpec{
+		AssociationCategory: data.AssociationCategory.ValueString(),
+		AssociationTypeID:   int(data.AssociationTypeID.ValueInt64()),
+	}
+
+	// Delete association via API
+	err := r.client.DeleteAssociation(ctx, data.FromObjectType.ValueString(), data.FromID.ValueString(),
+		data.ToObjectType.ValueString(), data.ToID.ValueString(), spec)
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Association",
+			fmt.Sprintf("Could not delete association %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing association resource by
+// from_object_type/from_id/to_object_type/to_id/association_category/association_type_id.
+func (r *AssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseImportID(req.ID, "from_object_type/from_id/to_object_type/to_id/association_category/association_type_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	typeID, err := strconv.ParseInt(parts[5], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("association_type_id must be a number, got %q", parts[5]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("to_object_type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("to_id"), parts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("association_category"), strings.ToUpper(parts[4]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("association_type_id"), typeID)...)
+}
+
+// resolveAssociationType finds the association type definition matching the
+// configured type ID or label, and category when set.
+func (r *AssociationResource) resolveAssociationType(ctx context.Context, data AssociationResourceModel) (client.AssociationType, error) {
+	definitions, err := r.client.ListAssociationLabels(ctx, data.FromObjectType.ValueString(), data.ToObjectType.ValueString())
+	if err != nil {
+		return client.AssociationType{}, err
+	}
+
+	for _, definition := range definitions {
+		if !data.AssociationTypeID.IsNull() && !data.AssociationTypeID.IsUnknown() &&
+			int64(definition.TypeID) != data.AssociationTypeID.ValueInt64() {
+			continue
+		}
+		if !data.Label.IsNull() && !data.Label.IsUnknown() && definition.Label != data.Label.ValueString() {
+			continue
+		}
+		if !data.AssociationCategory.IsNull() && !data.AssociationCategory.IsUnknown() &&
+			definition.Category != data.AssociationCategory.ValueString() {
+			continue
+		}
+
+		return definition, nil
+	}
+
+	return client.AssociationType{}, fmt.Errorf("no association type matches the configured association_type_id, label and association_category")
+}
+
+// setAssociationType stores the association type and the derived ID in the model.
+func (m *AssociationResourceModel) setAssociationType(associationType client.AssociationType) {
+	m.AssociationCategory = types.StringValue(associationType.Category)
+	m.AssociationTypeID = types.Int64Value(int64(associationType.TypeID))
+	if associationType.Label != "" {
+		m.Label = types.StringValue(associationType.Label)
+	} else {
+		m.Label = types.StringNull()
+	}
+
+	m.ID = types.StringValue(strings.Join([]string{
+		m.FromObjectType.ValueString(),
+		m.FromID.ValueString(),
+		m.ToObjectType.ValueString(),
+		m.ToID.ValueString(),
+		associationType.Category,
+		strconv.Itoa(associationType.TypeID),
+	}, "/"))
+}
@@ -0,0 +1,430 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewCompanyResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CompanyResource{}
+var _ resource.ResourceWithImportState = &CompanyResource{}
+var _ resource.ResourceWithValidateConfig = &CompanyResource{}
+
+// NewCompanyResource creates a new company resource.
+func NewCompanyResource() resource.Resource {
+	return &CompanyResource{}
+}
+
+// CompanyResource defines the resource implementation.
+type CompanyResource struct {
+	client *client.Client
+}
+
+// CompanyResourceModel describes the resource data model.
+type CompanyResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Domain                types.String `tfsdk:"domain"`
+	Industry              types.String `tfsdk:"industry"`
+	Properties            types.Map    `tfsdk:"properties"`
+	AdoptExistingByDomain types.Bool   `tfsdk:"adopt_existing_by_domain"`
+	ReconcileOnCreate     types.Bool   `tfsdk:"reconcile_on_create"`
+	PortalID              types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *CompanyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_company"
+}
+
+// Schema defines the schema for the resource.
+func (r *CompanyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a HubSpot company.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the company.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the company.",
+				Required:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "The company's primary website domain, without scheme or path (e.g. example.com).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`),
+						"must be a valid domain name without scheme or path",
+					),
+				},
+			},
+			"industry": schema.StringAttribute{
+				Description: "The industry the company belongs to, as a HubSpot industry enumeration value (e.g. COMPUTER_SOFTWARE).",
+				Optional:    true,
+			},
+			"properties": schema.MapAttribute{
+				Description: "Additional custom properties for the company.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"adopt_existing_by_domain": schema.BoolAttribute{
+				Description: "When true, creating the resource first searches for an existing company with the same domain " +
+					"and adopts it (applying the configured properties) instead of creating a duplicate. Requires domain to be set.",
+				Optional: true,
+			},
+			"reconcile_on_create": schema.BoolAttribute{
+				Description: "When true (the default) and domain is set, a create that fails ambiguously (timeout, network or server error) " +
+					"searches for the company by domain before retrying, so a company HubSpot did create is adopted instead of duplicated.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CompanyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, r.client, "hubspot_company", "crm.objects.companies.read", "crm.objects.companies.write")
+}
+
+// ValidateConfig ensures adoption by domain is only requested when a domain is configured.
+func (r *CompanyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CompanyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AdoptExistingByDomain.ValueBool() && data.Domain.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("adopt_existing_by_domain"),
+			"Missing Company Domain",
+			"adopt_existing_by_domain requires the domain attribute to be set.",
+		)
+	}
+}
+
+// Create creates a new company resource, adopting an existing company with
+// the same domain when configured to do so.
+func (r *CompanyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CompanyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	properties, diags := companyProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AdoptExistingByDomain.ValueBool() && !data.Domain.IsNull() {
+		existing, err := r.client.GetCompanyByDomain(ctx, data.Domain.ValueString())
+		switch {
+		case err == nil:
+			// Adopt the existing company and bring it in line with the configuration
+			if _, err := r.client.UpdateCompany(ctx, existing.ID, properties); err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Adopting Company",
+					fmt.Sprintf("Could not update existing company ID %s with domain %s", existing.ID, data.Domain.ValueString()),
+					err,
+				)
+				return
+			}
+
+			data.ID = types.StringValue(existing.ID)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		case client.IsNotFound(err):
+			// No existing company, fall through to create one
+		default:
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Searching Company",
+				fmt.Sprintf("Could not search for an existing company with domain %s", data.Domain.ValueString()),
+				err,
+			)
+			return
+		}
+	}
+
+	// Create company via API
+	var company *client.Company
+	var err error
+	if (data.ReconcileOnCreate.IsNull() || data.ReconcileOnCreate.ValueBool()) && !data.Domain.IsNull() {
+		company, err = r.client.CreateObjectReconciled(ctx, client.ObjectTypeCompanies, properties, client.CreateKey{
+			Property: "domain",
+			Value:    data.Domain.ValueString(),
+			Search:   true,
+		})
+	} else {
+		company, err = r.client.CreateCompany(ctx, properties)
+	}
+	if err != nil {
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
+			"Error Creating Company",
+			"Could not create company",
+			err,
+			"name", "domain", "industry",
+		)
+		return
+	}
+
+	// Set the ID
+	data.ID = types.StringValue(company.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the company resource.
+func (r *CompanyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CompanyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get company from API, with every property under management
+	names, diags := propertyNames(ctx, data.Properties, "name", "domain", "industry")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	company, err := r.client.GetCompany(ctx, data.ID.ValueString(), names...)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Company no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Company",
+			fmt.Sprintf("Could not read company ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Update model with API response
+	if name, ok := company.Properties["name"].(string); ok {
+		data.Name = types.StringValue(name)
+	}
+	if domain, ok := company.Properties["domain"].(string); ok {
+		data.Domain = types.StringValue(domain)
+	}
+	if industry, ok := company.Properties["industry"].(string); ok {
+		data.Industry = types.StringValue(industry)
+	}
+
+	// Handle custom properties
+	if !data.Properties.IsNull() {
+		customProps := make(map[string]string)
+		diags := data.Properties.ElementsAs(ctx, &customProps, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Update custom properties from API response
+		updatedProps := make(map[string]string)
+		for key := range customProps {
+			if val, ok := company.Properties[key]; ok {
+				if strVal, ok := val.(string); ok {
+					updatedProps[key] = strVal
+				}
+			}
+		}
+
+		propsMap, diags := types.MapValueFrom(ctx, types.StringType, updatedProps)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Properties = propsMap
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the company resource.
+func (r *CompanyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CompanyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	properties, diags := companyProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update company via API
+	_, err := r.client.UpdateCompany(ctx, data.ID.ValueString(), properties)
+	if err != nil {
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
+			"Error Updating Company",
+			fmt.Sprintf("Could not update company ID %s", data.ID.ValueString()),
+			err,
+			"name", "domain", "industry",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the company resource.
+func (r *CompanyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CompanyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete company via API
+	err := r.client.DeleteCompany(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Company",
+			fmt.Sprintf("Could not delete company ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing company resource by ID.
+func (r *CompanyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the ID provided in the import command
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// companyProperties builds the HubSpot properties payload from the resource model.
+func companyProperties(ctx context.Context, data CompanyResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	properties := make(map[string]interface{})
+
+	// Add required and optional fields
+	if !data.Name.IsNull() {
+		properties["name"] = data.Name.ValueString()
+	}
+	if !data.Domain.IsNull() {
+		properties["domain"] = data.Domain.ValueString()
+	}
+	if !data.Industry.IsNull() {
+		properties["industry"] = data.Industry.ValueString()
+	}
+
+	// Add custom properties
+	if !data.Properties.IsNull() {
+		customProps := make(map[string]string)
+		diags := data.Properties.ElementsAs(ctx, &customProps, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for key, value := range customProps {
+			properties[key] = value
+		}
+	}
+
+	return properties, nil
+}
+
+// propertyNames returns the names of the properties to read for an object:
+// the given properties of its top-level attributes and the keys of its
+// custom properties map.
+func propertyNames(ctx context.Context, custom types.Map, attributes ...string) ([]string, diag.Diagnostics) {
+	names := append([]string(nil), attributes...)
+	if custom.IsNull() || custom.IsUnknown() {
+		return names, nil
+	}
+
+	customProps := make(map[string]string)
+	if diags := custom.ElementsAs(ctx, &customProps, false); diags.HasError() {
+		return nil, diags
+	}
+	for key := range customProps {
+		names = append(names, key)
+	}
+	sort.Strings(names[len(attributes):])
+
+	return names, nil
+}
@@ -14,8 +14,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
 )
 
+func init() {
+	registry.RegisterResource(NewContactResource)
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ContactResource{}
 var _ resource.ResourceWithImportState = &ContactResource{}
@@ -32,11 +38,13 @@ type ContactResource struct {
 
 // ContactResourceModel describes the resource data model.
 type ContactResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Email      types.String `tfsdk:"email"`
-	Firstname  types.String `tfsdk:"firstname"`
-	Lastname   types.String `tfsdk:"lastname"`
-	Properties types.Map    `tfsdk:"properties"`
+	ID                types.String `tfsdk:"id"`
+	Email             types.String `tfsdk:"email"`
+	Firstname         types.String `tfsdk:"firstname"`
+	Lastname          types.String `tfsdk:"lastname"`
+	Properties        types.Map    `tfsdk:"properties"`
+	ReconcileOnCreate types.Bool   `tfsdk:"reconcile_on_create"`
+	PortalID          types.Int64  `tfsdk:"portal_id"`
 }
 
 // Metadata returns the resource type name.
@@ -56,6 +64,7 @@ func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"portal_id": portalIDAttribute(),
 			"email": schema.StringAttribute{
 				Description: "The email address of the contact.",
 				Required:    true,
@@ -79,6 +88,11 @@ func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"reconcile_on_create": schema.BoolAttribute{
+				Description: "When true (the default), a create that fails ambiguously (timeout, network or server error) " +
+					"looks the contact up by email before retrying, so a contact HubSpot did create is adopted instead of duplicated.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -100,6 +114,8 @@ func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	r.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, r.client, "hubspot_contact", "crm.objects.contacts.read", "crm.objects.contacts.write")
 }
 
 // Create creates a new contact resource.
@@ -112,6 +128,10 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
 	// Build properties map
 	properties := make(map[string]interface{})
 	
@@ -141,11 +161,23 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create contact via API
-	contact, err := r.client.CreateContact(ctx, properties)
+	var contact *client.Contact
+	var err error
+	if data.ReconcileOnCreate.IsNull() || data.ReconcileOnCreate.ValueBool() {
+		contact, err = r.client.CreateObjectReconciled(ctx, client.ObjectTypeContacts, properties, client.CreateKey{
+			Property: "email",
+			Value:    data.Email.ValueString(),
+		})
+	} else {
+		contact, err = r.client.CreateContact(ctx, properties)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
 			"Error Creating Contact",
-			fmt.Sprintf("Could not create contact: %s", err.Error()),
+			"Could not create contact",
+			err,
+			"email", "firstname", "lastname",
 		)
 		return
 	}
@@ -167,19 +199,24 @@ func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
 	// Get contact from API
 	contact, err := r.client.GetContact(ctx, data.ID.ValueString())
 	if err != nil {
-		// Check if this is a 404 error
-		if hubspotErr, ok := err.(*client.HubSpotError); ok && hubspotErr.Status == "404" {
+		if client.IsNotFound(err) {
 			// Contact no longer exists, remove from state
 			resp.State.RemoveResource(ctx)
 			return
 		}
 		
-		resp.Diagnostics.AddError(
+		diagnostics.AddError(
+			&resp.Diagnostics,
 			"Error Reading Contact",
-			fmt.Sprintf("Could not read contact ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read contact ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
@@ -237,6 +274,10 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
 	// Build properties map
 	properties := make(map[string]interface{})
 	
@@ -268,9 +309,12 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update contact via API
 	_, err := r.client.UpdateContact(ctx, data.ID.ValueString(), properties)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
 			"Error Updating Contact",
-			fmt.Sprintf("Could not update contact ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update contact ID %s", data.ID.ValueString()),
+			err,
+			"email", "firstname", "lastname",
 		)
 		return
 	}
@@ -289,12 +333,18 @@ func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
 	// Delete contact via API
 	err := r.client.DeleteContact(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
 			"Error Deleting Contact",
-			fmt.Sprintf("Could not delete contact ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not delete contact ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-hubspot/internal/client"
+)
+
+func TestContactResourceDeleteNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/account-info/v3/details":
+			fmt.Fprint(w, `{"portalId":123}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/crm/v3/objects/contacts/1":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status":"error","message":"resource not found","category":"OBJECT_NOT_FOUND"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	r := &ContactResource{client: client.NewClient(client.Config{APIToken: "pat-test-token", BaseURL: server.URL})}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &ContactResourceModel{
+		ID:                types.StringValue("1"),
+		Email:             types.StringValue("jane@example.com"),
+		Firstname:         types.StringNull(),
+		Lastname:          types.StringNull(),
+		Properties:        types.MapNull(types.StringType),
+		ReconcileOnCreate: types.BoolNull(),
+		PortalID:          types.Int64Value(123),
+	})
+	if diags.HasError() {
+		t.Fatalf("state diagnostics: %v", diags)
+	}
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics for a contact that is already gone, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,993 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewCustomObjectSchemaResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomObjectSchemaResource{}
+var _ resource.ResourceWithImportState = &CustomObjectSchemaResource{}
+var _ resource.ResourceWithModifyPlan = &CustomObjectSchemaResource{}
+
+// NewCustomObjectSchemaResource creates a new custom object schema resource.
+func NewCustomObjectSchemaResource() resource.Resource {
+	return &CustomObjectSchemaResource{}
+}
+
+// CustomObjectSchemaResource defines the resource implementation.
+type CustomObjectSchemaResource struct {
+	client *client.Client
+}
+
+// CustomObjectSchemaResourceModel describes the resource data model.
+type CustomObjectSchemaResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	FullyQualifiedName         types.String `tfsdk:"fully_qualified_name"`
+	Labels                     types.Object `tfsdk:"labels"`
+	PrimaryDisplayProperty     types.String `tfsdk:"primary_display_property"`
+	RequiredProperties         types.Set    `tfsdk:"required_properties"`
+	SearchableProperties       types.Set    `tfsdk:"searchable_properties"`
+	SecondaryDisplayProperties types.List   `tfsdk:"secondary_display_properties"`
+	AssociatedObjects          types.Set    `tfsdk:"associated_objects"`
+	Properties                 types.List   `tfsdk:"properties"`
+	ForceDestroy               types.Bool   `tfsdk:"force_destroy"`
+	PortalID                   types.Int64  `tfsdk:"portal_id"`
+}
+
+// CustomObjectLabelsModel describes the display names of a custom object.
+type CustomObjectLabelsModel struct {
+	Singular types.String `tfsdk:"singular"`
+	Plural   types.String `tfsdk:"plural"`
+}
+
+// CustomObjectPropertyModel describes a property defined by a custom object schema.
+type CustomObjectPropertyModel struct {
+	Name           types.String `tfsdk:"name"`
+	Label          types.String `tfsdk:"label"`
+	Type           types.String `tfsdk:"type"`
+	FieldType      types.String `tfsdk:"field_type"`
+	GroupName      types.String `tfsdk:"group_name"`
+	Description    types.String `tfsdk:"description"`
+	Options        types.List   `tfsdk:"options"`
+	HasUniqueValue types.Bool   `tfsdk:"has_unique_value"`
+}
+
+// customObjectLabelsAttrTypes are the attribute types of the labels object.
+var customObjectLabelsAttrTypes = map[string]attr.Type{
+	"singular": types.StringType,
+	"plural":   types.StringType,
+}
+
+// customObjectPropertyAttrTypes are the attribute types of a properties list element.
+var customObjectPropertyAttrTypes = map[string]attr.Type{
+	"name":             types.StringType,
+	"label":            types.StringType,
+	"type":             types.StringType,
+	"field_type":       types.StringType,
+	"group_name":       types.StringType,
+	"description":      types.StringType,
+	"options":          types.ListType{ElemType: types.ObjectType{AttrTypes: propertyOptionAttrTypes}},
+	"has_unique_value": types.BoolType,
+}
+
+// objectTypeIDRegex matches HubSpot object type IDs such as 0-1 or 2-12345.
+var objectTypeIDRegex = regexp.MustCompile(`^\d+-\d+$`)
+
+// Metadata returns the resource type name.
+func (r *CustomObjectSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object_schema"
+}
+
+// Schema defines the schema for the resource.
+func (r *CustomObjectSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a HubSpot custom object schema. Changes HubSpot cannot apply in place " +
+			"(renaming the object, changing a property's type or uniqueness) force the object, and all of its records, to be recreated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The object type ID of the custom object (e.g. 2-12345).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The internal name of the custom object. Changing this forces a new custom object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "The fully qualified name of the custom object, usable as object type in the CRM APIs.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.SingleNestedAttribute{
+				Description: "The display names of the custom object.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"singular": schema.StringAttribute{
+						Description: "The label of a single record.",
+						Required:    true,
+					},
+					"plural": schema.StringAttribute{
+						Description: "The label of multiple records.",
+						Required:    true,
+					},
+				},
+			},
+			"primary_display_property": schema.StringAttribute{
+				Description: "The name of the property used as the display name of records.",
+				Optional:    true,
+			},
+			"required_properties": schema.SetAttribute{
+				Description: "The names of the properties that must be set when creating a record.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"searchable_properties": schema.SetAttribute{
+				Description: "The names of the properties indexed for search.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"secondary_display_properties": schema.ListAttribute{
+				Description: "The names of the properties shown below the display name of records.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"associated_objects": schema.SetAttribute{
+				Description: "The object type IDs (e.g. 0-1 for contacts) the custom object can be associated with.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(objectTypeIDRegex, "must be an object type ID such as 0-1"),
+					),
+				},
+			},
+			"properties": schema.ListNestedAttribute{
+				Description: "The properties defined by the custom object. Properties can be added, updated and removed in place; " +
+					"changing the type or uniqueness of a property forces a new custom object.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The internal name of the property.",
+							Required:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The human-readable label of the property.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The data type of the property.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(propertyTypes...),
+							},
+						},
+						"field_type": schema.StringAttribute{
+							Description: "How the property is displayed in HubSpot forms and the UI.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(propertyFieldTypes...),
+							},
+						},
+						"group_name": schema.StringAttribute{
+							Description: "The name of the property group. Defaults to the group HubSpot creates for the custom object.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the property.",
+							Optional:    true,
+						},
+						"options": propertyOptionsAttribute(),
+						"has_unique_value": schema.BoolAttribute{
+							Description: "Whether the property values must be unique across records.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Whether to archive all records of the custom object when it is destroyed. " +
+					"When false, destroying a custom object that still has records fails.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CustomObjectSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, r.client, "hubspot_custom_object_schema",
+		"crm.schemas.custom.read", "crm.schemas.custom.write", "crm.objects.custom.read")
+}
+
+// ModifyPlan reports which planned changes HubSpot applies in place and
+// which require recreating the custom object, and refuses to plan the
+// destruction of a custom object that still has records unless forced.
+func (r *CustomObjectSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state CustomObjectSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		r.checkRecords(ctx, state.ID.ValueString(), state.ForceDestroy.ValueBool(), &resp.Diagnostics)
+		return
+	}
+
+	var plan CustomObjectSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, diags := diffCustomObjectSchema(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete runs with the prior state when replacing, so force_destroy only
+	// applies once it has been applied
+	if len(changes.replace) > 0 {
+		resp.RequiresReplace = append(resp.RequiresReplace, changes.replacePaths...)
+		r.checkRecords(ctx, state.ID.ValueString(), state.ForceDestroy.ValueBool(), &resp.Diagnostics)
+	}
+
+	if detail := changes.String(); detail != "" {
+		resp.Diagnostics.AddWarning("Custom Object Schema Changes", detail)
+	}
+}
+
+// Create creates a new custom object schema resource.
+func (r *CustomObjectSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomObjectSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	input, diags := customObjectSchemaInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create schema via API
+	created, err := r.client.CreateObjectSchema(ctx, input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Custom Object Schema",
+			fmt.Sprintf("Could not create custom object %s", input.Name),
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSchema(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the custom object schema resource.
+func (r *CustomObjectSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomObjectSchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get schema from API
+	objectSchema, err := r.client.GetObjectSchema(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Custom object no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Custom Object Schema",
+			fmt.Sprintf("Could not read custom object %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived schemas are treated as deleted
+	if objectSchema.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported custom objects have no force_destroy in state yet
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(data.setSchema(ctx, objectSchema)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the custom object schema resource. Properties are created
+// and updated before the schema itself, so the schema can reference them,
+// and archived afterwards, so the schema no longer references them.
+func (r *CustomObjectSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CustomObjectSchemaResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	objectTypeID := data.ID.ValueString()
+
+	var planned, prior []CustomObjectPropertyModel
+	resp.Diagnostics.Append(data.Properties.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorByName := make(map[string]CustomObjectPropertyModel, len(prior))
+	defaultGroup := ""
+	for _, property := range prior {
+		priorByName[property.Name.ValueString()] = property
+		if defaultGroup == "" && !property.GroupName.IsNull() {
+			defaultGroup = property.GroupName.ValueString()
+		}
+	}
+
+	plannedNames := make(map[string]bool, len(planned))
+	for _, property := range planned {
+		name := property.Name.ValueString()
+		plannedNames[name] = true
+
+		definition, diags := customObjectPropertyDefinition(ctx, property)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if definition.GroupName == "" {
+			definition.GroupName = defaultGroup
+		}
+
+		existing, ok := priorByName[name]
+		if !ok {
+			if _, err := r.client.CreateProperty(ctx, objectTypeID, definition); err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Creating Custom Object Property",
+					fmt.Sprintf("Could not create property %s of custom object %s", name, objectTypeID),
+					err,
+				)
+				return
+			}
+			continue
+		}
+
+		if customObjectPropertyChanged(existing, property) {
+			if _, err := r.client.UpdateProperty(ctx, objectTypeID, name, definition); err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Updating Custom Object Property",
+					fmt.Sprintf("Could not update property %s of custom object %s", name, objectTypeID),
+					err,
+				)
+				return
+			}
+		}
+	}
+
+	input, diags := customObjectSchemaInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update schema via API
+	_, err := r.client.UpdateObjectSchema(ctx, objectTypeID, &client.ObjectSchemaUpdate{
+		Labels:                     input.Labels,
+		PrimaryDisplayProperty:     input.PrimaryDisplayProperty,
+		RequiredProperties:         input.RequiredProperties,
+		SearchableProperties:       input.SearchableProperties,
+		SecondaryDisplayProperties: input.SecondaryDisplayProperties,
+	})
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Custom Object Schema",
+			fmt.Sprintf("Could not update custom object %s", objectTypeID),
+			err,
+		)
+		return
+	}
+
+	for _, property := range prior {
+		name := property.Name.ValueString()
+		if plannedNames[name] {
+			continue
+		}
+		if err := r.client.DeleteProperty(ctx, objectTypeID, name); err != nil && !client.IsNotFound(err) {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Archiving Custom Object Property",
+				fmt.Sprintf("Could not archive property %s of custom object %s", name, objectTypeID),
+				err,
+			)
+			return
+		}
+	}
+
+	objectSchema, err := r.client.GetObjectSchema(ctx, objectTypeID)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Custom Object Schema",
+			fmt.Sprintf("Could not read custom object %s after update", objectTypeID),
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncSchemaAssociations(ctx, objectSchema, input.AssociatedObjects)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectSchema, err = r.client.GetObjectSchema(ctx, objectTypeID)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Custom Object Schema",
+			fmt.Sprintf("Could not read custom object %s after update", objectTypeID),
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSchema(ctx, objectSchema)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the custom object schema resource.
+func (r *CustomObjectSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomObjectSchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	objectTypeID := data.ID.ValueString()
+
+	r.checkRecords(ctx, objectTypeID, data.ForceDestroy.ValueBool(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ForceDestroy.ValueBool() {
+		// HubSpot refuses to delete a schema that still has records
+		for {
+			page, err := r.client.ListObjects(ctx, objectTypeID, client.BatchSize, "")
+			if err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Listing Custom Object Records",
+					fmt.Sprintf("Could not list records of custom object %s", objectTypeID),
+					err,
+				)
+				return
+			}
+			if len(page.Results) == 0 {
+				break
+			}
+
+			ids := make([]string, 0, len(page.Results))
+			for _, record := range page.Results {
+				ids = append(ids, record.ID)
+			}
+			if err := r.client.BatchArchiveObjects(ctx, objectTypeID, ids); err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Archiving Custom Object Records",
+					fmt.Sprintf("Could not archive records of custom object %s", objectTypeID),
+					err,
+				)
+				return
+			}
+		}
+	}
+
+	// Delete schema via API
+	if err := r.client.DeleteObjectSchema(ctx, objectTypeID); err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Custom Object Schema",
+			fmt.Sprintf("Could not delete custom object %s", objectTypeID),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing custom object schema resource by object type ID or fully qualified name.
+func (r *CustomObjectSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkRecords adds an error when the custom object still has records and
+// destroying it is not forced. It is a no-op before the provider is configured.
+func (r *CustomObjectSchemaResource) checkRecords(ctx context.Context, objectTypeID string, force bool, diags *diag.Diagnostics) {
+	if force || r.client == nil || objectTypeID == "" {
+		return
+	}
+
+	hasRecords, err := r.client.HasObjects(ctx, objectTypeID)
+	if err != nil {
+		diagnostics.AddError(
+			diags,
+			"Error Listing Custom Object Records",
+			fmt.Sprintf("Could not check custom object %s for records", objectTypeID),
+			err,
+		)
+		return
+	}
+
+	if hasRecords {
+		diags.AddError(
+			"Custom Object Has Records",
+			fmt.Sprintf("Custom object %s still has records and cannot be destroyed. "+
+				"Set force_destroy = true and apply it before destroying or recreating the custom object "+
+				"to archive all of its records when it is destroyed.", objectTypeID),
+		)
+	}
+}
+
+// syncSchemaAssociations adds and removes association definitions of the
+// custom object so that it can be associated with exactly the planned object types.
+func (r *CustomObjectSchemaResource) syncSchemaAssociations(ctx context.Context, objectSchema *client.ObjectSchema, planned []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wanted := make(map[string]bool, len(planned))
+	for _, objectTypeID := range planned {
+		wanted[objectTypeID] = true
+	}
+
+	existing := make(map[string]bool)
+	for _, association := range objectSchema.Associations {
+		if association.FromObjectTypeID != objectSchema.ObjectTypeID {
+			continue
+		}
+		existing[association.ToObjectTypeID] = true
+
+		if wanted[association.ToObjectTypeID] {
+			continue
+		}
+		if err := r.client.DeleteObjectSchemaAssociation(ctx, objectSchema.ObjectTypeID, association.ID); err != nil && !client.IsNotFound(err) {
+			diagnostics.AddError(
+				&diags,
+				"Error Removing Custom Object Association",
+				fmt.Sprintf("Could not remove the association of custom object %s with %s",
+					objectSchema.ObjectTypeID, association.ToObjectTypeID),
+				err,
+			)
+			return diags
+		}
+	}
+
+	for _, objectTypeID := range planned {
+		if existing[objectTypeID] {
+			continue
+		}
+		if _, err := r.client.CreateObjectSchemaAssociation(ctx, objectSchema.ObjectTypeID, objectTypeID); err != nil {
+			diagnostics.AddError(
+				&diags,
+				"Error Creating Custom Object Association",
+				fmt.Sprintf("Could not associate custom object %s with %s", objectSchema.ObjectTypeID, objectTypeID),
+				err,
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// setSchema stores the custom object schema returned by HubSpot in the
+// model. Only the properties already in the model are tracked; an imported
+// schema tracks all of its custom properties.
+func (m *CustomObjectSchemaResourceModel) setSchema(ctx context.Context, objectSchema *client.ObjectSchema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(objectSchema.ObjectTypeID)
+	m.Name = types.StringValue(objectSchema.Name)
+	m.FullyQualifiedName = types.StringValue(objectSchema.FullyQualifiedName)
+
+	labels, d := types.ObjectValueFrom(ctx, customObjectLabelsAttrTypes, CustomObjectLabelsModel{
+		Singular: types.StringValue(objectSchema.Labels.Singular),
+		Plural:   types.StringValue(objectSchema.Labels.Plural),
+	})
+	diags.Append(d...)
+	m.Labels = labels
+
+	if objectSchema.PrimaryDisplayProperty != "" || !m.PrimaryDisplayProperty.IsNull() {
+		m.PrimaryDisplayProperty = types.StringValue(objectSchema.PrimaryDisplayProperty)
+	}
+
+	m.RequiredProperties = stringSetValue(ctx, objectSchema.RequiredProperties, m.RequiredProperties, &diags)
+	m.SearchableProperties = stringSetValue(ctx, objectSchema.SearchableProperties, m.SearchableProperties, &diags)
+
+	if len(objectSchema.SecondaryDisplayProperties) > 0 || !m.SecondaryDisplayProperties.IsNull() {
+		secondary, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(objectSchema.SecondaryDisplayProperties))
+		diags.Append(d...)
+		m.SecondaryDisplayProperties = secondary
+	}
+
+	var associated []string
+	for _, association := range objectSchema.Associations {
+		if association.FromObjectTypeID == objectSchema.ObjectTypeID {
+			associated = append(associated, association.ToObjectTypeID)
+		}
+	}
+	m.AssociatedObjects = stringSetValue(ctx, associated, m.AssociatedObjects, &diags)
+
+	definitions := make(map[string]client.PropertyDefinition, len(objectSchema.Properties))
+	for _, property := range objectSchema.Properties {
+		definitions[property.Name] = property
+	}
+
+	var prior []CustomObjectPropertyModel
+	if !m.Properties.IsNull() && !m.Properties.IsUnknown() {
+		diags.Append(m.Properties.ElementsAs(ctx, &prior, false)...)
+	} else {
+		for _, property := range objectSchema.Properties {
+			if !property.HubSpotDefined && !property.Archived {
+				prior = append(prior, CustomObjectPropertyModel{
+					Name:        types.StringValue(property.Name),
+					Description: types.StringNull(),
+					Options:     types.ListNull(types.ObjectType{AttrTypes: propertyOptionAttrTypes}),
+				})
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	models := make([]CustomObjectPropertyModel, 0, len(prior))
+	for _, model := range prior {
+		definition, ok := definitions[model.Name.ValueString()]
+		if !ok || definition.Archived {
+			continue
+		}
+
+		model.Label = types.StringValue(definition.Label)
+		model.Type = types.StringValue(definition.Type)
+		model.FieldType = types.StringValue(definition.FieldType)
+		model.GroupName = types.StringValue(definition.GroupName)
+		if definition.Description != "" || !model.Description.IsNull() {
+			model.Description = types.StringValue(definition.Description)
+		}
+		model.HasUniqueValue = types.BoolValue(definition.HasUniqueValue)
+
+		options, d := propertyOptionsValue(ctx, definition.Type, definition.Options, model.Options)
+		diags.Append(d...)
+		model.Options = options
+
+		models = append(models, model)
+	}
+
+	properties, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: customObjectPropertyAttrTypes}, models)
+	diags.Append(d...)
+	m.Properties = properties
+
+	return diags
+}
+
+// customObjectSchemaInput builds the HubSpot schema create request from the resource model.
+func customObjectSchemaInput(ctx context.Context, data CustomObjectSchemaResourceModel) (*client.ObjectSchemaInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var labels CustomObjectLabelsModel
+	diags.Append(data.Labels.As(ctx, &labels, basetypes.ObjectAsOptions{})...)
+
+	input := &client.ObjectSchemaInput{
+		Name: data.Name.ValueString(),
+		Labels: client.ObjectSchemaLabels{
+			Singular: labels.Singular.ValueString(),
+			Plural:   labels.Plural.ValueString(),
+		},
+		PrimaryDisplayProperty:     data.PrimaryDisplayProperty.ValueString(),
+		RequiredProperties:         []string{},
+		SearchableProperties:       []string{},
+		SecondaryDisplayProperties: []string{},
+		AssociatedObjects:          []string{},
+	}
+
+	if !data.RequiredProperties.IsNull() {
+		diags.Append(data.RequiredProperties.ElementsAs(ctx, &input.RequiredProperties, false)...)
+	}
+	if !data.SearchableProperties.IsNull() {
+		diags.Append(data.SearchableProperties.ElementsAs(ctx, &input.SearchableProperties, false)...)
+	}
+	if !data.SecondaryDisplayProperties.IsNull() {
+		diags.Append(data.SecondaryDisplayProperties.ElementsAs(ctx, &input.SecondaryDisplayProperties, false)...)
+	}
+	if !data.AssociatedObjects.IsNull() {
+		diags.Append(data.AssociatedObjects.ElementsAs(ctx, &input.AssociatedObjects, false)...)
+	}
+
+	var properties []CustomObjectPropertyModel
+	diags.Append(data.Properties.ElementsAs(ctx, &properties, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, property := range properties {
+		definition, d := customObjectPropertyDefinition(ctx, property)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Properties = append(input.Properties, *definition)
+	}
+
+	return input, diags
+}
+
+// customObjectPropertyDefinition builds the HubSpot property definition of a custom object property.
+func customObjectPropertyDefinition(ctx context.Context, property CustomObjectPropertyModel) (*client.PropertyDefinition, diag.Diagnostics) {
+	definition := &client.PropertyDefinition{
+		Name:           property.Name.ValueString(),
+		Label:          property.Label.ValueString(),
+		Type:           property.Type.ValueString(),
+		FieldType:      property.FieldType.ValueString(),
+		Description:    property.Description.ValueString(),
+		HasUniqueValue: property.HasUniqueValue.ValueBool(),
+	}
+	if !property.GroupName.IsUnknown() {
+		definition.GroupName = property.GroupName.ValueString()
+	}
+
+	options, diags := propertyOptions(ctx, property.Options)
+	if diags.HasError() {
+		return nil, diags
+	}
+	definition.Options = options
+
+	return definition, nil
+}
+
+// customObjectPropertyChanged reports whether a property has changes HubSpot applies in place.
+func customObjectPropertyChanged(prior, planned CustomObjectPropertyModel) bool {
+	return !prior.Label.Equal(planned.Label) ||
+		!prior.FieldType.Equal(planned.FieldType) ||
+		!prior.Description.Equal(planned.Description) ||
+		!prior.Options.Equal(planned.Options) ||
+		(!planned.GroupName.IsUnknown() && !prior.GroupName.Equal(planned.GroupName))
+}
+
+// customObjectSchemaChanges describes the planned changes of a custom object
+// schema, split by whether HubSpot can apply them in place.
+type customObjectSchemaChanges struct {
+	inPlace      []string
+	replace      []string
+	replacePaths []path.Path
+}
+
+// String renders the changes for a plan warning, or an empty string when there are none.
+func (c customObjectSchemaChanges) String() string {
+	var sections []string
+	if len(c.inPlace) > 0 {
+		sections = append(sections, "HubSpot applies these changes in place:\n  - "+strings.Join(c.inPlace, "\n  - "))
+	}
+	if len(c.replace) > 0 {
+		sections = append(sections, "These changes require recreating the custom object, which deletes all of its records:\n  - "+
+			strings.Join(c.replace, "\n  - "))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// diffCustomObjectSchema compares the prior state of a custom object schema
+// with its plan.
+func diffCustomObjectSchema(ctx context.Context, state, plan CustomObjectSchemaResourceModel) (customObjectSchemaChanges, diag.Diagnostics) {
+	var changes customObjectSchemaChanges
+	var diags diag.Diagnostics
+
+	if !state.Name.Equal(plan.Name) {
+		changes.replace = append(changes.replace, fmt.Sprintf("rename the custom object to %s", plan.Name.ValueString()))
+		changes.replacePaths = append(changes.replacePaths, path.Root("name"))
+	}
+	if !state.Labels.Equal(plan.Labels) {
+		changes.inPlace = append(changes.inPlace, "update the labels")
+	}
+	if !state.PrimaryDisplayProperty.Equal(plan.PrimaryDisplayProperty) {
+		changes.inPlace = append(changes.inPlace, "change the primary display property")
+	}
+	if !state.RequiredProperties.Equal(plan.RequiredProperties) {
+		changes.inPlace = append(changes.inPlace, "change the required properties")
+	}
+	if !state.SearchableProperties.Equal(plan.SearchableProperties) {
+		changes.inPlace = append(changes.inPlace, "change the searchable properties")
+	}
+	if !state.SecondaryDisplayProperties.Equal(plan.SecondaryDisplayProperties) {
+		changes.inPlace = append(changes.inPlace, "change the secondary display properties")
+	}
+	if !state.AssociatedObjects.Equal(plan.AssociatedObjects) {
+		changes.inPlace = append(changes.inPlace, "change the associated object types")
+	}
+
+	if plan.Properties.IsUnknown() {
+		return changes, diags
+	}
+
+	var prior, planned []CustomObjectPropertyModel
+	diags.Append(state.Properties.ElementsAs(ctx, &prior, false)...)
+	diags.Append(plan.Properties.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return changes, diags
+	}
+
+	priorByName := make(map[string]CustomObjectPropertyModel, len(prior))
+	for _, property := range prior {
+		priorByName[property.Name.ValueString()] = property
+	}
+
+	plannedNames := make(map[string]bool, len(planned))
+	for i, property := range planned {
+		name := property.Name.ValueString()
+		plannedNames[name] = true
+
+		existing, ok := priorByName[name]
+		if !ok {
+			changes.inPlace = append(changes.inPlace, fmt.Sprintf("add property %s", name))
+			continue
+		}
+
+		if !property.Type.IsUnknown() && !existing.Type.Equal(property.Type) {
+			changes.replace = append(changes.replace, fmt.Sprintf("change the type of property %s from %s to %s", name, existing.Type.ValueString(), property.Type.ValueString()))
+			changes.replacePaths = append(changes.replacePaths, path.Root("properties").AtListIndex(i).AtName("type"))
+		}
+		if !property.HasUniqueValue.IsUnknown() && !existing.HasUniqueValue.Equal(property.HasUniqueValue) {
+			changes.replace = append(changes.replace, fmt.Sprintf("change the uniqueness of property %s", name))
+			changes.replacePaths = append(changes.replacePaths, path.Root("properties").AtListIndex(i).AtName("has_unique_value"))
+		}
+		if customObjectPropertyChanged(existing, property) {
+			changes.inPlace = append(changes.inPlace, fmt.Sprintf("update property %s", name))
+		}
+	}
+
+	for _, property := range prior {
+		if name := property.Name.ValueString(); !plannedNames[name] {
+			changes.inPlace = append(changes.inPlace, fmt.Sprintf("archive property %s", name))
+		}
+	}
+
+	return changes, diags
+}
+
+// stringSetValue converts strings returned by HubSpot into a set, keeping the
+// set null when HubSpot returns none and the prior value is null.
+func stringSetValue(ctx context.Context, values []string, prior types.Set, diags *diag.Diagnostics) types.Set {
+	if len(values) == 0 && prior.IsNull() {
+		return prior
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(values))
+	diags.Append(d...)
+	return set
+}
+
+// nonNilStrings returns values, or an empty slice when values is nil.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-hubspot/internal/client"
+)
+
+func testCustomObjectProperty(name, propertyType string, unique bool) CustomObjectPropertyModel {
+	return CustomObjectPropertyModel{
+		Name:           types.StringValue(name),
+		Label:          types.StringValue(name),
+		Type:           types.StringValue(propertyType),
+		FieldType:      types.StringValue("text"),
+		GroupName:      types.StringNull(),
+		Description:    types.StringNull(),
+		Options:        types.ListNull(types.ObjectType{AttrTypes: propertyOptionAttrTypes}),
+		HasUniqueValue: types.BoolValue(unique),
+	}
+}
+
+func testCustomObjectSchemaModel(t *testing.T, name string, forceDestroy bool, properties ...CustomObjectPropertyModel) CustomObjectSchemaResourceModel {
+	t.Helper()
+
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: customObjectPropertyAttrTypes}, properties)
+	if diags.HasError() {
+		t.Fatalf("building properties: %v", diags)
+	}
+
+	return CustomObjectSchemaResourceModel{
+		ID:                 types.StringValue("2-123"),
+		Name:               types.StringValue(name),
+		FullyQualifiedName: types.StringValue("p_" + name),
+		Labels: types.ObjectValueMust(customObjectLabelsAttrTypes, map[string]attr.Value{
+			"singular": types.StringValue("Car"),
+			"plural":   types.StringValue("Cars"),
+		}),
+		PrimaryDisplayProperty:     types.StringValue("model"),
+		RequiredProperties:         types.SetNull(types.StringType),
+		SearchableProperties:       types.SetNull(types.StringType),
+		SecondaryDisplayProperties: types.ListNull(types.StringType),
+		AssociatedObjects:          types.SetNull(types.StringType),
+		Properties:                 list,
+		ForceDestroy:               types.BoolValue(forceDestroy),
+		PortalID:                   types.Int64Null(),
+	}
+}
+
+func TestDiffCustomObjectSchema(t *testing.T) {
+	ctx := context.Background()
+	model := testCustomObjectProperty("model", "string", false)
+
+	relabeled := testCustomObjectSchemaModel(t, "cars", false, model)
+	relabeled.Labels = types.ObjectValueMust(customObjectLabelsAttrTypes, map[string]attr.Value{
+		"singular": types.StringValue("Vehicle"),
+		"plural":   types.StringValue("Vehicles"),
+	})
+
+	unknownProperties := testCustomObjectSchemaModel(t, "cars", false)
+	unknownProperties.Properties = types.ListUnknown(types.ObjectType{AttrTypes: customObjectPropertyAttrTypes})
+
+	tests := []struct {
+		name        string
+		plan        CustomObjectSchemaResourceModel
+		wantInPlace []string
+		wantReplace []string
+	}{
+		{
+			name: "no changes",
+			plan: testCustomObjectSchemaModel(t, "cars", false, model),
+		},
+		{
+			name:        "rename",
+			plan:        testCustomObjectSchemaModel(t, "vehicles", false, model),
+			wantReplace: []string{"rename the custom object to vehicles"},
+		},
+		{
+			name:        "labels",
+			plan:        relabeled,
+			wantInPlace: []string{"update the labels"},
+		},
+		{
+			name:        "add property",
+			plan:        testCustomObjectSchemaModel(t, "cars", false, model, testCustomObjectProperty("year", "number", false)),
+			wantInPlace: []string{"add property year"},
+		},
+		{
+			name:        "archive property",
+			plan:        testCustomObjectSchemaModel(t, "cars", false),
+			wantInPlace: []string{"archive property model"},
+		},
+		{
+			name:        "property type",
+			plan:        testCustomObjectSchemaModel(t, "cars", false, testCustomObjectProperty("model", "number", false)),
+			wantReplace: []string{"change the type of property model from string to number"},
+		},
+		{
+			name:        "property uniqueness",
+			plan:        testCustomObjectSchemaModel(t, "cars", false, testCustomObjectProperty("model", "string", true)),
+			wantReplace: []string{"change the uniqueness of property model"},
+		},
+		{
+			name: "unknown properties",
+			plan: unknownProperties,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testCustomObjectSchemaModel(t, "cars", false, model)
+
+			changes, diags := diffCustomObjectSchema(ctx, state, tt.plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if strings.Join(changes.inPlace, "; ") != strings.Join(tt.wantInPlace, "; ") {
+				t.Errorf("got in-place changes %q, want %q", changes.inPlace, tt.wantInPlace)
+			}
+			if strings.Join(changes.replace, "; ") != strings.Join(tt.wantReplace, "; ") {
+				t.Errorf("got replacing changes %q, want %q", changes.replace, tt.wantReplace)
+			}
+			if len(changes.replacePaths) != len(tt.wantReplace) {
+				t.Errorf("got %d replace paths, want %d", len(changes.replacePaths), len(tt.wantReplace))
+			}
+		})
+	}
+}
+
+func TestCustomObjectSchemaModifyPlanRecordGuard(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/crm/v3/objects/2-123" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"results":[{"id":"1","properties":{}}]}`)
+	}))
+	defer server.Close()
+
+	r := &CustomObjectSchemaResource{client: client.NewClient(client.Config{APIToken: "pat-test-token", BaseURL: server.URL})}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	model := testCustomObjectProperty("model", "string", false)
+
+	tests := []struct {
+		name      string
+		state     CustomObjectSchemaResourceModel
+		plan      *CustomObjectSchemaResourceModel
+		wantError bool
+	}{
+		{
+			name:      "replace",
+			state:     testCustomObjectSchemaModel(t, "cars", false, model),
+			plan:      ptr(testCustomObjectSchemaModel(t, "vehicles", false, model)),
+			wantError: true,
+		},
+		{
+			// Delete uses the prior force_destroy, so setting it along with
+			// the replacing change does not help
+			name:      "replace setting force_destroy",
+			state:     testCustomObjectSchemaModel(t, "cars", false, model),
+			plan:      ptr(testCustomObjectSchemaModel(t, "vehicles", true, model)),
+			wantError: true,
+		},
+		{
+			name:  "replace with force_destroy applied",
+			state: testCustomObjectSchemaModel(t, "cars", true, model),
+			plan:  ptr(testCustomObjectSchemaModel(t, "vehicles", true, model)),
+		},
+		{
+			name:  "in-place change",
+			state: testCustomObjectSchemaModel(t, "cars", false, model),
+			plan:  ptr(testCustomObjectSchemaModel(t, "cars", false, model, testCustomObjectProperty("year", "number", false))),
+		},
+		{
+			name:      "destroy",
+			state:     testCustomObjectSchemaModel(t, "cars", false, model),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			if diags := state.Set(ctx, &tt.state); diags.HasError() {
+				t.Fatalf("state diagnostics: %v", diags)
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			if tt.plan != nil {
+				if diags := plan.Set(ctx, tt.plan); diags.HasError() {
+					t.Fatalf("plan diagnostics: %v", diags)
+				}
+			}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("got diagnostics %v, want error %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,595 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewDealResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DealResource{}
+var _ resource.ResourceWithImportState = &DealResource{}
+var _ resource.ResourceWithModifyPlan = &DealResource{}
+
+// NewDealResource creates a new deal resource.
+func NewDealResource() resource.Resource {
+	return &DealResource{}
+}
+
+// DealResource defines the resource implementation.
+type DealResource struct {
+	client *client.Client
+}
+
+// DealResourceModel describes the resource data model.
+type DealResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Dealname     types.String `tfsdk:"dealname"`
+	Pipeline     types.String `tfsdk:"pipeline"`
+	Dealstage    types.String `tfsdk:"dealstage"`
+	Amount       types.String `tfsdk:"amount"`
+	Closedate    types.String `tfsdk:"closedate"`
+	Properties   types.Map    `tfsdk:"properties"`
+	Associations types.Map    `tfsdk:"associations"`
+	PortalID     types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *DealResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deal"
+}
+
+// Schema defines the schema for the resource.
+func (r *DealResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a HubSpot deal.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the deal.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"dealname": schema.StringAttribute{
+				Description: "The name of the deal.",
+				Required:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "The ID of the pipeline the deal belongs to. Defaults to the default sales pipeline.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+			},
+			"dealstage": schema.StringAttribute{
+				Description: "The ID of the deal stage. Must belong to the configured pipeline; this is checked at plan time.",
+				Required:    true,
+			},
+			"amount": schema.StringAttribute{
+				Description: "The amount of the deal.",
+				Optional:    true,
+			},
+			"closedate": schema.StringAttribute{
+				Description: "The expected close date of the deal, as an ISO 8601 date (2006-01-02) or timestamp.",
+				Optional:    true,
+			},
+			"properties": schema.MapAttribute{
+				Description: "Additional custom properties for the deal.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"associations": schema.MapAttribute{
+				Description: "Records associated with the deal, keyed by object type (e.g. contacts, companies) with the set of record IDs as values. " +
+					"Only the object types listed here are managed.",
+				Optional: true,
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DealResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+
+	diagnostics.CheckScopes(&resp.Diagnostics, r.client, "hubspot_deal", "crm.objects.deals.read", "crm.objects.deals.write")
+}
+
+// ModifyPlan validates that the planned deal stage belongs to the planned
+// pipeline, so an invalid stage fails at plan time instead of on apply.
+func (r *DealResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan DealResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Pipeline.IsUnknown() || plan.Dealstage.IsUnknown() {
+		return
+	}
+
+	// Skip the API call when neither pipeline nor stage changed
+	if !req.State.Raw.IsNull() {
+		var state DealResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Pipeline.Equal(plan.Pipeline) && state.Dealstage.Equal(plan.Dealstage) {
+			return
+		}
+	}
+
+	pipelineID := plan.Pipeline.ValueString()
+	stageID := plan.Dealstage.ValueString()
+
+	pipeline, err := r.client.GetPipeline(ctx, client.ObjectTypeDeals, pipelineID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pipeline"),
+				"Invalid Deal Pipeline",
+				fmt.Sprintf("Deal pipeline %q does not exist.", pipelineID),
+			)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Deal Pipeline",
+			fmt.Sprintf("Could not read deal pipeline %s to validate the deal stage", pipelineID),
+			err,
+		)
+		return
+	}
+
+	if stage := pipeline.Stage(stageID); stage == nil || stage.Archived {
+		validStages := make([]string, 0, len(pipeline.Stages))
+		for _, s := range pipeline.Stages {
+			if !s.Archived {
+				validStages = append(validStages, fmt.Sprintf("%s (%s)", s.ID, s.Label))
+			}
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dealstage"),
+			"Invalid Deal Stage",
+			fmt.Sprintf("Deal stage %q does not belong to pipeline %q (%s). Valid stages are: %s.",
+				stageID, pipelineID, pipeline.Label, strings.Join(validStages, ", ")),
+		)
+	}
+}
+
+// Create creates a new deal resource.
+func (r *DealResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DealResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	properties, diags := dealProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	associations, diags := associationIDs(ctx, data.Associations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create deal via API
+	deal, err := r.client.CreateDeal(ctx, properties)
+	if err != nil {
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
+			"Error Creating Deal",
+			"Could not create deal",
+			err,
+			"dealname", "pipeline", "dealstage", "amount", "closedate",
+		)
+		return
+	}
+
+	// Set the ID
+	data.ID = types.StringValue(deal.ID)
+
+	// Persist the deal before associating it so a failure below does not orphan it
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := syncAssociations(ctx, r.client, client.ObjectTypeDeals, deal.ID, nil, associations); err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Associating Deal",
+			fmt.Sprintf("Deal ID %s was created but could not be associated", deal.ID),
+			err,
+		)
+		return
+	}
+}
+
+// Read reads the deal resource.
+func (r *DealResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DealResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get deal from API, with every property under management
+	names, diags := propertyNames(ctx, data.Properties, "dealname", "pipeline", "dealstage", "amount", "closedate")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deal, err := r.client.GetDeal(ctx, data.ID.ValueString(), names...)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Deal no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Deal",
+			fmt.Sprintf("Could not read deal ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Update model with API response
+	if dealname, ok := deal.Properties["dealname"].(string); ok {
+		data.Dealname = types.StringValue(dealname)
+	}
+	if pipeline, ok := deal.Properties["pipeline"].(string); ok {
+		data.Pipeline = types.StringValue(pipeline)
+	}
+	if dealstage, ok := deal.Properties["dealstage"].(string); ok {
+		data.Dealstage = types.StringValue(dealstage)
+	}
+	if amount, ok := deal.Properties["amount"].(string); ok {
+		data.Amount = types.StringValue(amount)
+	}
+	if closedate, ok := deal.Properties["closedate"].(string); ok {
+		// HubSpot returns a full timestamp; keep the configured form when it is the same instant
+		if !sameInstant(data.Closedate.ValueString(), closedate) {
+			data.Closedate = types.StringValue(closedate)
+		}
+	}
+
+	// Handle custom properties
+	if !data.Properties.IsNull() {
+		customProps := make(map[string]string)
+		diags := data.Properties.ElementsAs(ctx, &customProps, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Update custom properties from API response
+		updatedProps := make(map[string]string)
+		for key := range customProps {
+			if val, ok := deal.Properties[key]; ok {
+				if strVal, ok := val.(string); ok {
+					updatedProps[key] = strVal
+				}
+			}
+		}
+
+		propsMap, diags := types.MapValueFrom(ctx, types.StringType, updatedProps)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Properties = propsMap
+	}
+
+	// Refresh associations for the object types under management
+	if !data.Associations.IsNull() {
+		managed, diags := associationIDs(ctx, data.Associations)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current := make(map[string][]string, len(managed))
+		for objectType := range managed {
+			ids, err := readAssociationIDs(ctx, r.client, client.ObjectTypeDeals, data.ID.ValueString(), objectType)
+			if err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Reading Deal Associations",
+					fmt.Sprintf("Could not read %s associations for deal ID %s", objectType, data.ID.ValueString()),
+					err,
+				)
+				return
+			}
+			current[objectType] = ids
+		}
+
+		associationsMap, diags := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, current)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Associations = associationsMap
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the deal resource.
+func (r *DealResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DealResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	properties, diags := dealProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := associationIDs(ctx, data.Associations)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := associationIDs(ctx, state.Associations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update deal via API
+	_, err := r.client.UpdateDeal(ctx, data.ID.ValueString(), properties)
+	if err != nil {
+		diagnostics.AddPropertyError(
+			&resp.Diagnostics,
+			"Error Updating Deal",
+			fmt.Sprintf("Could not update deal ID %s", data.ID.ValueString()),
+			err,
+			"dealname", "pipeline", "dealstage", "amount", "closedate",
+		)
+		return
+	}
+
+	if err := syncAssociations(ctx, r.client, client.ObjectTypeDeals, data.ID.ValueString(), prior, planned); err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Deal Associations",
+			fmt.Sprintf("Could not update associations for deal ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the deal resource.
+func (r *DealResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DealResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete deal via API
+	err := r.client.DeleteDeal(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Deal",
+			fmt.Sprintf("Could not delete deal ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing deal resource by ID.
+func (r *DealResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the ID provided in the import command
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// dealProperties builds the HubSpot properties payload from the resource model.
+func dealProperties(ctx context.Context, data DealResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	properties := make(map[string]interface{})
+
+	// Add required and optional fields
+	if !data.Dealname.IsNull() {
+		properties["dealname"] = data.Dealname.ValueString()
+	}
+	if !data.Pipeline.IsNull() {
+		properties["pipeline"] = data.Pipeline.ValueString()
+	}
+	if !data.Dealstage.IsNull() {
+		properties["dealstage"] = data.Dealstage.ValueString()
+	}
+	if !data.Amount.IsNull() {
+		properties["amount"] = data.Amount.ValueString()
+	}
+	if !data.Closedate.IsNull() {
+		properties["closedate"] = data.Closedate.ValueString()
+	}
+
+	// Add custom properties
+	if !data.Properties.IsNull() {
+		customProps := make(map[string]string)
+		diags := data.Properties.ElementsAs(ctx, &customProps, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for key, value := range customProps {
+			properties[key] = value
+		}
+	}
+
+	return properties, nil
+}
+
+// associationIDs converts an associations map attribute into record IDs keyed by object type.
+func associationIDs(ctx context.Context, associations types.Map) (map[string][]string, diag.Diagnostics) {
+	ids := make(map[string][]string)
+	if associations.IsNull() || associations.IsUnknown() {
+		return ids, nil
+	}
+
+	diags := associations.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// readAssociationIDs returns the sorted IDs of the toObjectType records associated with a record.
+func readAssociationIDs(ctx context.Context, c *client.Client, fromObjectType, fromID, toObjectType string) ([]string, error) {
+	associated, err := c.ListAssociations(ctx, fromObjectType, fromID, toObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(associated))
+	for _, a := range associated {
+		ids = append(ids, strconv.FormatInt(a.ToObjectID, 10))
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// syncAssociations creates and removes default associations so that the
+// record ends up associated with exactly the planned IDs for every object
+// type present in either the prior or the planned set.
+func syncAssociations(ctx context.Context, c *client.Client, fromObjectType, fromID string, prior, planned map[string][]string) error {
+	objectTypes := make(map[string]struct{})
+	for objectType := range prior {
+		objectTypes[objectType] = struct{}{}
+	}
+	for objectType := range planned {
+		objectTypes[objectType] = struct{}{}
+	}
+
+	for objectType := range objectTypes {
+		before := make(map[string]bool, len(prior[objectType]))
+		for _, id := range prior[objectType] {
+			before[id] = true
+		}
+		after := make(map[string]bool, len(planned[objectType]))
+		for _, id := range planned[objectType] {
+			after[id] = true
+		}
+
+		for id := range after {
+			if !before[id] {
+				if err := c.CreateDefaultAssociation(ctx, fromObjectType, fromID, objectType, id); err != nil {
+					return err
+				}
+			}
+		}
+		for id := range before {
+			if !after[id] {
+				if err := c.DeleteAssociations(ctx, fromObjectType, fromID, objectType, id); err != nil && !client.IsNotFound(err) {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// sameInstant reports whether two date or timestamp strings refer to the same point in time.
+func sameInstant(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	parse := func(s string) (time.Time, bool) {
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+
+	ta, okA := parse(a)
+	tb, okB := parse(b)
+	return okA && okB && ta.Equal(tb)
+}
@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseImportID splits a composite import ID such as "contacts/my_property"
+// into non-empty segments, one per segment of format (e.g. "object_type/name").
+// The last segment keeps any remaining slashes.
+func parseImportID(id string, format string) ([]string, error) {
+	expected := strings.Split(format, "/")
+	segments := strings.SplitN(id, "/", len(expected))
+
+	if len(segments) != len(expected) {
+		return nil, fmt.Errorf("expected import ID in the format %q, got %q", format, id)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("expected import ID in the format %q, got %q", format, id)
+		}
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,596 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewPipelineResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PipelineResource{}
+var _ resource.ResourceWithImportState = &PipelineResource{}
+var _ resource.ResourceWithModifyPlan = &PipelineResource{}
+
+// NewPipelineResource creates a new pipeline resource.
+func NewPipelineResource() resource.Resource {
+	return &PipelineResource{}
+}
+
+// PipelineResource defines the resource implementation.
+type PipelineResource struct {
+	client *client.Client
+}
+
+// PipelineResourceModel describes the resource data model.
+type PipelineResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	Label        types.String `tfsdk:"label"`
+	DisplayOrder types.Int64  `tfsdk:"display_order"`
+	Stages       types.List   `tfsdk:"stages"`
+	PortalID     types.Int64  `tfsdk:"portal_id"`
+}
+
+// PipelineStageModel describes a stage within the pipeline's stages list.
+type PipelineStageModel struct {
+	ID       types.String `tfsdk:"id"`
+	Label    types.String `tfsdk:"label"`
+	Metadata types.Map    `tfsdk:"metadata"`
+}
+
+// pipelineStageAttrTypes are the attribute types of a stages list element.
+var pipelineStageAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"label":    types.StringType,
+	"metadata": types.MapType{ElemType: types.StringType},
+}
+
+// Metadata returns the resource type name.
+func (r *PipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+// Schema defines the schema for the resource.
+func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a HubSpot pipeline and its ordered stages. Do not combine with hubspot_pipeline_stage resources for the same pipeline.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the pipeline.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"object_type": schema.StringAttribute{
+				Description: "The object type the pipeline belongs to (e.g. deals, tickets). Changing this forces a new pipeline.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The human-readable label of the pipeline.",
+				Required:    true,
+			},
+			"display_order": schema.Int64Attribute{
+				Description: "The position of the pipeline relative to other pipelines of the same object type.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"stages": schema.ListNestedAttribute{
+				Description: "The stages of the pipeline, in display order. Stages are matched to existing stages by label, " +
+					"then by position, so reordering or renaming a stage keeps its ID. HubSpot refuses to delete a stage that records are still in.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the stage.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The human-readable label of the stage.",
+							Required:    true,
+						},
+						"metadata": schema.MapAttribute{
+							Description: "Stage metadata. Deal stages require probability (0.0 to 1.0) and accept isClosed; " +
+								"ticket stages accept ticketState (OPEN or CLOSED).",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage pipelines of the
+// planned object type.
+func (r *PipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_pipeline", req, &resp.Diagnostics, pipelineScopes, "object_type")
+}
+
+// Create creates a new pipeline resource.
+func (r *PipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	var stages []PipelineStageModel
+	resp.Diagnostics.Append(data.Stages.ElementsAs(ctx, &stages, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &client.PipelineInput{
+		Label: data.Label.ValueString(),
+	}
+	if !data.DisplayOrder.IsUnknown() && !data.DisplayOrder.IsNull() {
+		input.DisplayOrder = int(data.DisplayOrder.ValueInt64())
+	}
+	for i, stage := range stages {
+		stageInput, diags := stage.input(ctx, i)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Stages = append(input.Stages, *stageInput)
+	}
+
+	// Create pipeline via API
+	pipeline, err := r.client.CreatePipeline(ctx, data.ObjectType.ValueString(), input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Pipeline",
+			fmt.Sprintf("Could not create %s pipeline", data.ObjectType.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Set the IDs of the pipeline and its stages, which HubSpot returns keyed by display order
+	data.ID = types.StringValue(pipeline.ID)
+	data.DisplayOrder = types.Int64Value(int64(pipeline.DisplayOrder))
+
+	stageIDs := make(map[int]string, len(pipeline.Stages))
+	for _, stage := range pipeline.Stages {
+		stageIDs[stage.DisplayOrder] = stage.ID
+	}
+	for i := range stages {
+		stages[i].ID = types.StringValue(stageIDs[i])
+	}
+
+	resp.Diagnostics.Append(data.setStages(ctx, stages)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the pipeline resource.
+func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get pipeline from API
+	pipeline, err := r.client.GetPipeline(ctx, data.ObjectType.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Pipeline no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Pipeline",
+			fmt.Sprintf("Could not read %s pipeline ID %s", data.ObjectType.ValueString(), data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived pipelines are treated as deleted
+	if pipeline.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model with API response
+	data.Label = types.StringValue(pipeline.Label)
+	data.DisplayOrder = types.Int64Value(int64(pipeline.DisplayOrder))
+
+	// Index the prior stages so only metadata keys under management are refreshed
+	var prior []PipelineStageModel
+	if !data.Stages.IsNull() {
+		resp.Diagnostics.Append(data.Stages.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	priorByID := make(map[string]PipelineStageModel, len(prior))
+	for _, stage := range prior {
+		priorByID[stage.ID.ValueString()] = stage
+	}
+
+	active := make([]client.PipelineStage, 0, len(pipeline.Stages))
+	for _, stage := range pipeline.Stages {
+		if !stage.Archived {
+			active = append(active, stage)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].DisplayOrder < active[j].DisplayOrder
+	})
+
+	stages := make([]PipelineStageModel, 0, len(active))
+	for _, stage := range active {
+		model := PipelineStageModel{
+			ID:    types.StringValue(stage.ID),
+			Label: types.StringValue(stage.Label),
+		}
+
+		priorStage, known := priorByID[stage.ID]
+		metadata, diags := refreshMetadata(ctx, stage.Metadata, priorStage.Metadata, known)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		model.Metadata = metadata
+
+		stages = append(stages, model)
+	}
+
+	resp.Diagnostics.Append(data.setStages(ctx, stages)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the pipeline resource, reconciling its stages one by one so
+// that existing stages keep their IDs and records stay in place.
+func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PipelineResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	var planned, prior []PipelineStageModel
+	resp.Diagnostics.Append(data.Stages.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Stages.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType := data.ObjectType.ValueString()
+	pipelineID := data.ID.ValueString()
+	stageIDs := matchStageIDs(prior, planned)
+
+	// Delete removed stages first so a stage that is still in use fails the
+	// apply before anything else has changed
+	kept := make(map[string]bool, len(stageIDs))
+	for _, id := range stageIDs {
+		kept[id] = true
+	}
+	for _, stage := range prior {
+		if kept[stage.ID.ValueString()] {
+			continue
+		}
+
+		if err := r.client.DeletePipelineStage(ctx, objectType, pipelineID, stage.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+			addStageDeleteError(&resp.Diagnostics, stage.Label.ValueString(), stage.ID.ValueString(), pipelineID, err)
+			return
+		}
+	}
+
+	// Update kept stages in their new position and create new ones
+	for i := range planned {
+		stageInput, diags := planned[i].input(ctx, i)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if stageIDs[i] != "" {
+			if _, err := r.client.UpdatePipelineStage(ctx, objectType, pipelineID, stageIDs[i], stageInput); err != nil {
+				diagnostics.AddError(
+					&resp.Diagnostics,
+					"Error Updating Pipeline Stage",
+					fmt.Sprintf("Could not update stage %q (ID %s) of pipeline %s", stageInput.Label, stageIDs[i], pipelineID),
+					err,
+				)
+				return
+			}
+			planned[i].ID = types.StringValue(stageIDs[i])
+			continue
+		}
+
+		created, err := r.client.CreatePipelineStage(ctx, objectType, pipelineID, stageInput)
+		if err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Creating Pipeline Stage",
+				fmt.Sprintf("Could not create stage %q in pipeline %s", stageInput.Label, pipelineID),
+				err,
+			)
+			return
+		}
+		planned[i].ID = types.StringValue(created.ID)
+	}
+
+	// Update the pipeline itself
+	if !data.Label.Equal(state.Label) || !data.DisplayOrder.Equal(state.DisplayOrder) {
+		input := &client.PipelineInput{
+			Label:        data.Label.ValueString(),
+			DisplayOrder: int(data.DisplayOrder.ValueInt64()),
+		}
+
+		updated, err := r.client.UpdatePipeline(ctx, objectType, pipelineID, input)
+		if err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Updating Pipeline",
+				fmt.Sprintf("Could not update %s pipeline ID %s", objectType, pipelineID),
+				err,
+			)
+			return
+		}
+		data.DisplayOrder = types.Int64Value(int64(updated.DisplayOrder))
+	}
+
+	resp.Diagnostics.Append(data.setStages(ctx, planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the pipeline resource.
+func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete pipeline via API
+	err := r.client.DeletePipeline(ctx, data.ObjectType.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		if isValidationError(err) {
+			resp.Diagnostics.AddError(
+				"Pipeline Still In Use",
+				fmt.Sprintf("HubSpot refused to delete %s pipeline ID %s: %s\n\n"+
+					"A pipeline cannot be deleted while records are still in any of its stages. "+
+					"Move or delete those records, then apply again.",
+					data.ObjectType.ValueString(), data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Pipeline",
+			fmt.Sprintf("Could not delete %s pipeline ID %s", data.ObjectType.ValueString(), data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing pipeline resource by object_type/pipeline_id.
+func (r *PipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseImportID(req.ID, "object_type/pipeline_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// setStages stores the stage models in the resource model.
+func (m *PipelineResourceModel) setStages(ctx context.Context, stages []PipelineStageModel) diag.Diagnostics {
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pipelineStageAttrTypes}, stages)
+	if diags.HasError() {
+		return diags
+	}
+	m.Stages = list
+
+	return nil
+}
+
+// input builds the HubSpot stage payload for the stage at the given position.
+func (m PipelineStageModel) input(ctx context.Context, displayOrder int) (*client.PipelineStageInput, diag.Diagnostics) {
+	input := &client.PipelineStageInput{
+		Label:        m.Label.ValueString(),
+		DisplayOrder: displayOrder,
+		Metadata:     map[string]string{},
+	}
+
+	if !m.Metadata.IsNull() && !m.Metadata.IsUnknown() {
+		if diags := m.Metadata.ElementsAs(ctx, &input.Metadata, false); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return input, nil
+}
+
+// matchStageIDs returns, for each planned stage, the ID of the prior stage it
+// updates, or "" for a new stage. Stages are matched by label first, then any
+// remaining stage takes over the unmatched prior stage at the same position,
+// which treats an in-place label change as a rename rather than a replacement.
+func matchStageIDs(prior, planned []PipelineStageModel) []string {
+	ids := make([]string, len(planned))
+	used := make(map[int]bool, len(prior))
+
+	for i, stage := range planned {
+		for j, priorStage := range prior {
+			if !used[j] && priorStage.Label.Equal(stage.Label) {
+				ids[i] = priorStage.ID.ValueString()
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	for i := range planned {
+		if ids[i] == "" && i < len(prior) && !used[i] {
+			ids[i] = prior[i].ID.ValueString()
+			used[i] = true
+		}
+	}
+
+	return ids
+}
+
+// refreshMetadata returns the stage metadata to store in state. Only keys
+// present in the prior state are tracked, since HubSpot adds its own keys
+// (such as isClosed) to stages; an imported stage tracks every key.
+func refreshMetadata(ctx context.Context, metadata map[string]string, prior types.Map, known bool) (types.Map, diag.Diagnostics) {
+	if !known {
+		if len(metadata) == 0 {
+			return types.MapNull(types.StringType), nil
+		}
+		return types.MapValueFrom(ctx, types.StringType, metadata)
+	}
+
+	if prior.IsNull() {
+		return prior, nil
+	}
+
+	priorValues := make(map[string]string)
+	if diags := prior.ElementsAs(ctx, &priorValues, false); diags.HasError() {
+		return prior, diags
+	}
+
+	refreshed := make(map[string]string, len(priorValues))
+	for key := range priorValues {
+		if value, ok := metadata[key]; ok {
+			refreshed[key] = value
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, refreshed)
+}
+
+// isValidationError reports whether HubSpot rejected a request as invalid,
+// which is how it refuses to delete stages and pipelines still in use.
+func isValidationError(err error) bool {
+	var hubspotErr *client.HubSpotError
+	if !errors.As(err, &hubspotErr) {
+		return false
+	}
+
+	return hubspotErr.StatusCode == http.StatusBadRequest || hubspotErr.StatusCode == http.StatusConflict
+}
+
+// addStageDeleteError reports a failed stage deletion, explaining the likely
+// cause when HubSpot refused it because records are still in the stage.
+func addStageDeleteError(diags *diag.Diagnostics, label, stageID, pipelineID string, err error) {
+	if isValidationError(err) {
+		diags.AddError(
+			"Pipeline Stage Still In Use",
+			fmt.Sprintf("HubSpot refused to delete stage %q (ID %s) of pipeline %s: %s\n\n"+
+				"A stage cannot be deleted while records are still in it. "+
+				"Move those records to another stage, then apply again.",
+				label, stageID, pipelineID, err.Error()),
+		)
+		return
+	}
+
+	diagnostics.AddError(
+		diags,
+		"Error Deleting Pipeline Stage",
+		fmt.Sprintf("Could not delete stage %q (ID %s) of pipeline %s", label, stageID, pipelineID),
+		err,
+	)
+}
@@ -0,0 +1,325 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewPipelineStageResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PipelineStageResource{}
+var _ resource.ResourceWithImportState = &PipelineStageResource{}
+var _ resource.ResourceWithModifyPlan = &PipelineStageResource{}
+
+// NewPipelineStageResource creates a new pipeline stage resource.
+func NewPipelineStageResource() resource.Resource {
+	return &PipelineStageResource{}
+}
+
+// PipelineStageResource defines the resource implementation.
+type PipelineStageResource struct {
+	client *client.Client
+}
+
+// PipelineStageResourceModel describes the resource data model.
+type PipelineStageResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	PipelineID   types.String `tfsdk:"pipeline_id"`
+	Label        types.String `tfsdk:"label"`
+	DisplayOrder types.Int64  `tfsdk:"display_order"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+	PortalID     types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *PipelineStageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_stage"
+}
+
+// Schema defines the schema for the resource.
+func (r *PipelineStageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single stage of an existing HubSpot pipeline. Do not combine with the stages of a hubspot_pipeline resource for the same pipeline.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the stage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"object_type": schema.StringAttribute{
+				Description: "The object type of the pipeline (e.g. deals, tickets). Changing this forces a new stage.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pipeline_id": schema.StringAttribute{
+				Description: "The ID of the pipeline the stage belongs to. Changing this forces a new stage.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The human-readable label of the stage.",
+				Required:    true,
+			},
+			"display_order": schema.Int64Attribute{
+				Description: "The position of the stage within the pipeline.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Stage metadata. Deal stages require probability (0.0 to 1.0) and accept isClosed; " +
+					"ticket stages accept ticketState (OPEN or CLOSED).",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PipelineStageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage pipeline stages of the
+// planned object type.
+func (r *PipelineStageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_pipeline_stage", req, &resp.Diagnostics, pipelineScopes, "object_type")
+}
+
+// Create creates a new pipeline stage resource.
+func (r *PipelineStageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PipelineStageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	input := &client.PipelineStageInput{
+		Label:    data.Label.ValueString(),
+		Metadata: map[string]string{},
+	}
+	if !data.Metadata.IsNull() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &input.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.DisplayOrder.IsUnknown() && !data.DisplayOrder.IsNull() {
+		input.DisplayOrder = int(data.DisplayOrder.ValueInt64())
+	} else {
+		// Append the stage after the existing stages of the pipeline
+		pipeline, err := r.client.GetPipeline(ctx, data.ObjectType.ValueString(), data.PipelineID.ValueString())
+		if err != nil {
+			diagnostics.AddError(
+				&resp.Diagnostics,
+				"Error Reading Pipeline",
+				fmt.Sprintf("Could not read pipeline %s to position the new stage", data.PipelineID.ValueString()),
+				err,
+			)
+			return
+		}
+		for _, stage := range pipeline.Stages {
+			if stage.DisplayOrder >= input.DisplayOrder {
+				input.DisplayOrder = stage.DisplayOrder + 1
+			}
+		}
+	}
+
+	// Create stage via API
+	stage, err := r.client.CreatePipelineStage(ctx, data.ObjectType.ValueString(), data.PipelineID.ValueString(), input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Pipeline Stage",
+			fmt.Sprintf("Could not create stage %q in pipeline %s", input.Label, data.PipelineID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Set the ID and values computed by HubSpot
+	data.ID = types.StringValue(stage.ID)
+	data.DisplayOrder = types.Int64Value(int64(stage.DisplayOrder))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the pipeline stage resource.
+func (r *PipelineStageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PipelineStageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get stage from API
+	stage, err := r.client.GetPipelineStage(ctx, data.ObjectType.ValueString(), data.PipelineID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Stage or its pipeline no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Pipeline Stage",
+			fmt.Sprintf("Could not read stage ID %s of pipeline %s", data.ID.ValueString(), data.PipelineID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived stages are treated as deleted
+	if stage.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model with API response
+	data.Label = types.StringValue(stage.Label)
+	data.DisplayOrder = types.Int64Value(int64(stage.DisplayOrder))
+
+	// An imported stage has no metadata in state yet and tracks every key
+	metadata, diags := refreshMetadata(ctx, stage.Metadata, data.Metadata, !data.Label.IsNull())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Metadata = metadata
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the pipeline stage resource.
+func (r *PipelineStageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PipelineStageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	input := &client.PipelineStageInput{
+		Label:        data.Label.ValueString(),
+		DisplayOrder: int(data.DisplayOrder.ValueInt64()),
+		Metadata:     map[string]string{},
+	}
+	if !data.Metadata.IsNull() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &input.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Update stage via API
+	stage, err := r.client.UpdatePipelineStage(ctx, data.ObjectType.ValueString(), data.PipelineID.ValueString(), data.ID.ValueString(), input)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Pipeline Stage",
+			fmt.Sprintf("Could not update stage ID %s of pipeline %s", data.ID.ValueString(), data.PipelineID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	data.DisplayOrder = types.Int64Value(int64(stage.DisplayOrder))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the pipeline stage resource.
+func (r *PipelineStageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PipelineStageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete stage via API
+	err := r.client.DeletePipelineStage(ctx, data.ObjectType.ValueString(), data.PipelineID.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addStageDeleteError(&resp.Diagnostics, data.Label.ValueString(), data.ID.ValueString(), data.PipelineID.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports an existing pipeline stage resource by object_type/pipeline_id/stage_id.
+func (r *PipelineStageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseImportID(req.ID, "object_type/pipeline_id/stage_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pipeline_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+)
+
+// portalIDAttribute is the schema of the portal_id attribute every resource
+// records the portal of its object in.
+func portalIDAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The ID of the HubSpot portal the object lives in. Operations are refused when the provider is authenticated with a different portal.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// checkPortal is called before every create, read, update and delete so that
+// an object is never managed through a provider authenticated with a
+// different portal. It sets portalID, the portal recorded in state, to the
+// portal the provider is authenticated with, adding an error and returning
+// false if they differ. A null or unknown portalID, as for new and imported
+// objects and state written before portal_id existed, matches any portal.
+//
+// The lookup is best effort: when it fails, a warning is added and portalID
+// is kept. It is only an error when there is a portal to check against,
+// i.e. expected_portal_id is configured or portalID is recorded.
+func checkPortal(ctx context.Context, c *client.Client, portalID *types.Int64, diags *diag.Diagnostics) bool {
+	recorded := !portalID.IsNull() && !portalID.IsUnknown()
+
+	current, err := c.PortalID(ctx)
+	if err != nil {
+		if recorded || c.ExpectedPortalID() != 0 {
+			diagnostics.AddError(
+				diags,
+				"Error Reading Portal",
+				"Could not determine the HubSpot portal the provider is authenticated with",
+				err,
+			)
+			return false
+		}
+
+		diagnostics.AddWarning(
+			diags,
+			"Error Reading Portal",
+			"Could not determine the HubSpot portal the provider is authenticated with, so portal_id is not recorded",
+			err,
+		)
+		if portalID.IsUnknown() {
+			*portalID = types.Int64Null()
+		}
+		return true
+	}
+
+	if recorded && portalID.ValueInt64() != current {
+		diags.AddError(
+			"Portal Mismatch",
+			fmt.Sprintf("The object lives in portal %d, but the provider is authenticated with portal %d. "+
+				"Use a provider configuration for portal %d to manage it.", portalID.ValueInt64(), current, portalID.ValueInt64()),
+		)
+		return false
+	}
+
+	*portalID = types.Int64Value(current)
+	return true
+}
@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+)
+
+func TestCheckPortalLookupFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"status":"error","message":"missing scopes","category":"MISSING_SCOPES"}`)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		expectedPortalID int64
+		portalID         types.Int64
+		want             types.Int64
+		wantError        bool
+	}{
+		{name: "new object", portalID: types.Int64Unknown(), want: types.Int64Null()},
+		{name: "no portal recorded", portalID: types.Int64Null(), want: types.Int64Null()},
+		{name: "portal recorded", portalID: types.Int64Value(123), want: types.Int64Value(123), wantError: true},
+		{name: "expected portal configured", expectedPortalID: 123, portalID: types.Int64Unknown(), want: types.Int64Unknown(), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := client.NewClient(client.Config{
+				APIToken:         "pat-test-token",
+				BaseURL:          server.URL,
+				ExpectedPortalID: tt.expectedPortalID,
+			})
+
+			var diags diag.Diagnostics
+			got := tt.portalID
+			ok := checkPortal(context.Background(), c, &got, &diags)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got portal %v, want %v", got, tt.want)
+			}
+			if ok == tt.wantError {
+				t.Errorf("got ok %v, want %v", ok, !tt.wantError)
+			}
+			if diags.HasError() != tt.wantError {
+				t.Errorf("got diagnostics %v, want error %v", diags, tt.wantError)
+			}
+			if !tt.wantError && diags.WarningsCount() != 1 {
+				t.Errorf("got diagnostics %v, want one warning", diags)
+			}
+		})
+	}
+}
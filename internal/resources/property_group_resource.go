@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewPropertyGroupResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PropertyGroupResource{}
+var _ resource.ResourceWithImportState = &PropertyGroupResource{}
+var _ resource.ResourceWithModifyPlan = &PropertyGroupResource{}
+
+// NewPropertyGroupResource creates a new property group resource.
+func NewPropertyGroupResource() resource.Resource {
+	return &PropertyGroupResource{}
+}
+
+// PropertyGroupResource defines the resource implementation.
+type PropertyGroupResource struct {
+	client *client.Client
+}
+
+// PropertyGroupResourceModel describes the resource data model.
+type PropertyGroupResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	Name         types.String `tfsdk:"name"`
+	Label        types.String `tfsdk:"label"`
+	DisplayOrder types.Int64  `tfsdk:"display_order"`
+	PortalID     types.Int64  `tfsdk:"portal_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *PropertyGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_property_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *PropertyGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a HubSpot property group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the property group, in the format object_type/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"object_type": schema.StringAttribute{
+				Description: "The object type the property group belongs to (e.g. contacts, companies, deals). Changing this forces a new property group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The internal name of the property group, referenced by the group_name of properties. Changing this forces a new property group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The human-readable label of the property group.",
+				Required:    true,
+			},
+			"display_order": schema.Int64Attribute{
+				Description: "The position of the property group relative to other groups. Groups with the same value are ordered alphabetically.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PropertyGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage property groups of the
+// planned object type.
+func (r *PropertyGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_property_group", req, &resp.Diagnostics, schemaScopes, "object_type")
+}
+
+// Create creates a new property group resource.
+func (r *PropertyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PropertyGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	group := &client.PropertyGroup{
+		Name:         data.Name.ValueString(),
+		Label:        data.Label.ValueString(),
+		DisplayOrder: -1,
+	}
+	if !data.DisplayOrder.IsUnknown() && !data.DisplayOrder.IsNull() {
+		group.DisplayOrder = int(data.DisplayOrder.ValueInt64())
+	}
+
+	// Create property group via API
+	created, err := r.client.CreatePropertyGroup(ctx, data.ObjectType.ValueString(), group)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Property Group",
+			fmt.Sprintf("Could not create %s property group %s", data.ObjectType.ValueString(), data.Name.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Set the ID and values computed by HubSpot
+	data.ID = types.StringValue(data.ObjectType.ValueString() + "/" + created.Name)
+	data.DisplayOrder = types.Int64Value(int64(created.DisplayOrder))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the property group resource.
+func (r *PropertyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PropertyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get property group from API
+	group, err := r.client.GetPropertyGroup(ctx, data.ObjectType.ValueString(), data.Name.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Property group no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Property Group",
+			fmt.Sprintf("Could not read property group %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived groups are treated as deleted
+	if group.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model with API response
+	data.Label = types.StringValue(group.Label)
+	data.DisplayOrder = types.Int64Value(int64(group.DisplayOrder))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the property group resource.
+func (r *PropertyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PropertyGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	group := &client.PropertyGroup{
+		Label:        data.Label.ValueString(),
+		DisplayOrder: int(data.DisplayOrder.ValueInt64()),
+	}
+
+	// Update property group via API
+	updated, err := r.client.UpdatePropertyGroup(ctx, data.ObjectType.ValueString(), data.Name.ValueString(), group)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Property Group",
+			fmt.Sprintf("Could not update property group %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	data.DisplayOrder = types.Int64Value(int64(updated.DisplayOrder))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the property group resource.
+func (r *PropertyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PropertyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete property group via API
+	err := r.client.DeletePropertyGroup(ctx, data.ObjectType.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Property Group",
+			fmt.Sprintf("Could not delete property group %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing property group resource by object_type/name.
+func (r *PropertyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseImportID(req.ID, "object_type/name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
@@ -0,0 +1,505 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+	"terraform-provider-hubspot/internal/provider/registry"
+)
+
+func init() {
+	registry.RegisterResource(NewPropertyResource)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PropertyResource{}
+var _ resource.ResourceWithImportState = &PropertyResource{}
+var _ resource.ResourceWithModifyPlan = &PropertyResource{}
+
+// NewPropertyResource creates a new property resource.
+func NewPropertyResource() resource.Resource {
+	return &PropertyResource{}
+}
+
+// PropertyResource defines the resource implementation.
+type PropertyResource struct {
+	client *client.Client
+}
+
+// PropertyResourceModel describes the resource data model.
+type PropertyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ObjectType     types.String `tfsdk:"object_type"`
+	Name           types.String `tfsdk:"name"`
+	Label          types.String `tfsdk:"label"`
+	Type           types.String `tfsdk:"type"`
+	FieldType      types.String `tfsdk:"field_type"`
+	GroupName      types.String `tfsdk:"group_name"`
+	Description    types.String `tfsdk:"description"`
+	Options        types.List   `tfsdk:"options"`
+	HasUniqueValue types.Bool   `tfsdk:"has_unique_value"`
+	Hidden         types.Bool   `tfsdk:"hidden"`
+	PortalID       types.Int64  `tfsdk:"portal_id"`
+}
+
+// PropertyOptionModel describes a single enumeration option.
+type PropertyOptionModel struct {
+	Label        types.String `tfsdk:"label"`
+	Value        types.String `tfsdk:"value"`
+	Description  types.String `tfsdk:"description"`
+	DisplayOrder types.Int64  `tfsdk:"display_order"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
+}
+
+// propertyOptionAttrTypes are the attribute types of an options list element.
+var propertyOptionAttrTypes = map[string]attr.Type{
+	"label":         types.StringType,
+	"value":         types.StringType,
+	"description":   types.StringType,
+	"display_order": types.Int64Type,
+	"hidden":        types.BoolType,
+}
+
+// propertyTypes are the data types a HubSpot property can have.
+var propertyTypes = []string{"string", "number", "date", "datetime", "enumeration", "bool"}
+
+// propertyFieldTypes are the field types a HubSpot property can be displayed as.
+var propertyFieldTypes = []string{
+	"text", "textarea", "date", "file", "number", "select", "radio",
+	"checkbox", "booleancheckbox", "calculation_equation", "html", "phonenumber",
+}
+
+// Metadata returns the resource type name.
+func (r *PropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_property"
+}
+
+// Schema defines the schema for the resource.
+func (r *PropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom HubSpot property definition.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the property, in the format object_type/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_id": portalIDAttribute(),
+			"object_type": schema.StringAttribute{
+				Description: "The object type the property belongs to (e.g. contacts, companies, deals, or a custom object type ID). Changing this forces a new property.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The internal name of the property. Changing this forces a new property.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The human-readable label of the property.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The data type of the property. Changing this forces a new property.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(propertyTypes...),
+				},
+			},
+			"field_type": schema.StringAttribute{
+				Description: "How the property is displayed in HubSpot forms and the UI.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(propertyFieldTypes...),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				Description: "The name of the property group the property belongs to. Reference a hubspot_property_group's name to create the group first.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the property.",
+				Optional:    true,
+			},
+			"options": propertyOptionsAttribute(),
+			"has_unique_value": schema.BoolAttribute{
+				Description: "Whether the property values must be unique across records. Changing this forces a new property.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"hidden": schema.BoolAttribute{
+				Description: "Whether the property is hidden from the HubSpot UI.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the token grants the scopes to manage properties of the
+// planned object type.
+func (r *PropertyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkObjectTypeScopes(ctx, r.client, "hubspot_property", req, &resp.Diagnostics, schemaScopes, "object_type")
+}
+
+// Create creates a new property resource.
+func (r *PropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PropertyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	property, diags := propertyDefinition(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create property via API
+	created, err := r.client.CreateProperty(ctx, data.ObjectType.ValueString(), property)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Creating Property",
+			fmt.Sprintf("Could not create %s property %s", data.ObjectType.ValueString(), data.Name.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Set the ID and values computed by HubSpot
+	data.ID = types.StringValue(data.ObjectType.ValueString() + "/" + created.Name)
+	resp.Diagnostics.Append(data.setOptions(ctx, created.Options)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the property resource.
+func (r *PropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Get property from API
+	property, err := r.client.GetProperty(ctx, data.ObjectType.ValueString(), data.Name.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Property no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Reading Property",
+			fmt.Sprintf("Could not read property %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived properties are treated as deleted
+	if property.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model with API response
+	data.Label = types.StringValue(property.Label)
+	data.Type = types.StringValue(property.Type)
+	data.FieldType = types.StringValue(property.FieldType)
+	data.GroupName = types.StringValue(property.GroupName)
+	if property.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(property.Description)
+	}
+	data.HasUniqueValue = types.BoolValue(property.HasUniqueValue)
+	data.Hidden = types.BoolValue(property.Hidden)
+
+	resp.Diagnostics.Append(data.setOptions(ctx, property.Options)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the property resource.
+func (r *PropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PropertyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	property, diags := propertyDefinition(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update property via API
+	updated, err := r.client.UpdateProperty(ctx, data.ObjectType.ValueString(), data.Name.ValueString(), property)
+	if err != nil {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Updating Property",
+			fmt.Sprintf("Could not update property %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setOptions(ctx, updated.Options)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the property resource.
+func (r *PropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkPortal(ctx, r.client, &data.PortalID, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete property via API
+	err := r.client.DeleteProperty(ctx, data.ObjectType.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		diagnostics.AddError(
+			&resp.Diagnostics,
+			"Error Deleting Property",
+			fmt.Sprintf("Could not delete property %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ImportState imports an existing property resource by object_type/name.
+func (r *PropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseImportID(req.ID, "object_type/name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// setOptions stores the options returned by HubSpot in the model, keeping
+// the options attribute null when they are not managed.
+func (m *PropertyResourceModel) setOptions(ctx context.Context, options []client.Option) diag.Diagnostics {
+	list, diags := propertyOptionsValue(ctx, m.Type.ValueString(), options, m.Options)
+	if diags.HasError() {
+		return diags
+	}
+	m.Options = list
+
+	return nil
+}
+
+// propertyDefinition builds the HubSpot property definition from the resource model.
+func propertyDefinition(ctx context.Context, data PropertyResourceModel) (*client.PropertyDefinition, diag.Diagnostics) {
+	property := &client.PropertyDefinition{
+		Name:           data.Name.ValueString(),
+		Label:          data.Label.ValueString(),
+		Type:           data.Type.ValueString(),
+		FieldType:      data.FieldType.ValueString(),
+		GroupName:      data.GroupName.ValueString(),
+		Description:    data.Description.ValueString(),
+		HasUniqueValue: data.HasUniqueValue.ValueBool(),
+		Hidden:         data.Hidden.ValueBool(),
+	}
+
+	options, diags := propertyOptions(ctx, data.Options)
+	if diags.HasError() {
+		return nil, diags
+	}
+	property.Options = options
+
+	return property, nil
+}
+
+// propertyOptionsAttribute returns the schema of an enumeration options list.
+func propertyOptionsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "The options of an enumeration property, in display order.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"label": schema.StringAttribute{
+					Description: "The label of the option.",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The internal value of the option.",
+					Required:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "A description of the option.",
+					Optional:    true,
+				},
+				"display_order": schema.Int64Attribute{
+					Description: "The display order of the option. Defaults to the option's position in the list.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"hidden": schema.BoolAttribute{
+					Description: "Whether the option is hidden from forms and the UI.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// propertyOptionsValue converts the options returned by HubSpot into an
+// options list, returning null when neither HubSpot nor the prior value has
+// any. Only enumeration options are managed: HubSpot also returns the fixed
+// true/false options of bool properties, which stay null unless configured.
+func propertyOptionsValue(ctx context.Context, propertyType string, options []client.Option, prior types.List) (types.List, diag.Diagnostics) {
+	if prior.IsNull() && (len(options) == 0 || propertyType != "enumeration") {
+		return prior, nil
+	}
+
+	var configured []PropertyOptionModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if diags := prior.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return prior, diags
+		}
+	}
+
+	models := make([]PropertyOptionModel, 0, len(options))
+	for i, option := range options {
+		model := PropertyOptionModel{
+			Label:        types.StringValue(option.Label),
+			Value:        types.StringValue(option.Value),
+			Description:  types.StringNull(),
+			DisplayOrder: types.Int64Value(int64(option.DisplayOrder)),
+			Hidden:       types.BoolValue(option.Hidden),
+		}
+		if option.Description != "" || (i < len(configured) && !configured[i].Description.IsNull()) {
+			model.Description = types.StringValue(option.Description)
+		}
+		models = append(models, model)
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: propertyOptionAttrTypes}, models)
+}
+
+// propertyOptions converts an options list into HubSpot property options.
+func propertyOptions(ctx context.Context, list types.List) ([]client.Option, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []PropertyOptionModel
+	if diags := list.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, diags
+	}
+
+	options := make([]client.Option, 0, len(models))
+	for i, option := range models {
+		displayOrder := int64(i)
+		if !option.DisplayOrder.IsNull() && !option.DisplayOrder.IsUnknown() {
+			displayOrder = option.DisplayOrder.ValueInt64()
+		}
+
+		options = append(options, client.Option{
+			Label:        option.Label.ValueString(),
+			Value:        option.Value.ValueString(),
+			Description:  option.Description.ValueString(),
+			DisplayOrder: int(displayOrder),
+			Hidden:       option.Hidden.ValueBool(),
+		})
+	}
+
+	return options, nil
+}
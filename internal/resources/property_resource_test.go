@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+)
+
+func TestPropertyOptionsValue(t *testing.T) {
+	ctx := context.Background()
+
+	boolOptions := []client.Option{
+		{Label: "Yes", Value: "true", DisplayOrder: 0},
+		{Label: "No", Value: "false", DisplayOrder: 1},
+	}
+
+	configured, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: propertyOptionAttrTypes}, []PropertyOptionModel{
+		{
+			Label:        types.StringValue("Yes"),
+			Value:        types.StringValue("true"),
+			Description:  types.StringNull(),
+			DisplayOrder: types.Int64Value(0),
+			Hidden:       types.BoolValue(false),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("building configured options: %v", diags)
+	}
+	unconfigured := types.ListNull(types.ObjectType{AttrTypes: propertyOptionAttrTypes})
+
+	tests := []struct {
+		name         string
+		propertyType string
+		options      []client.Option
+		prior        types.List
+		wantNull     bool
+		wantLen      int
+	}{
+		{name: "bool without configured options", propertyType: "bool", options: boolOptions, prior: unconfigured, wantNull: true},
+		{name: "bool with configured options", propertyType: "bool", options: boolOptions, prior: configured, wantLen: 2},
+		{name: "string without options", propertyType: "string", prior: unconfigured, wantNull: true},
+		{name: "imported enumeration", propertyType: "enumeration", options: boolOptions, prior: unconfigured, wantLen: 2},
+		{name: "enumeration without options", propertyType: "enumeration", prior: unconfigured, wantNull: true},
+		{name: "enumeration with configured options", propertyType: "enumeration", options: boolOptions[:1], prior: configured, wantLen: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := propertyOptionsValue(ctx, tt.propertyType, tt.options, tt.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got.IsNull() != tt.wantNull {
+				t.Fatalf("got null %v, want %v", got.IsNull(), tt.wantNull)
+			}
+			if !tt.wantNull && len(got.Elements()) != tt.wantLen {
+				t.Errorf("got %d options, want %d", len(got.Elements()), tt.wantLen)
+			}
+		})
+	}
+}
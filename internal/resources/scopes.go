@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hubspot/internal/client"
+	"terraform-provider-hubspot/internal/diagnostics"
+)
+
+// scopeObjectNames maps the names and type IDs of the standard object types
+// to the name HubSpot uses for them in scopes
+var scopeObjectNames = map[string]string{
+	client.ObjectTypeContacts:  "contacts",
+	"contact":                  "contacts",
+	"0-1":                      "contacts",
+	client.ObjectTypeCompanies: "companies",
+	"company":                  "companies",
+	"0-2":                      "companies",
+	client.ObjectTypeDeals:     "deals",
+	"deal":                     "deals",
+	"0-3":                      "deals",
+	client.ObjectTypeTickets:   "tickets",
+	"ticket":                   "tickets",
+	"0-5":                      "tickets",
+}
+
+// scopeObjectName returns the name of objectType in scopes, "custom" for
+// custom objects, or "" for object types whose scopes are not checked
+func scopeObjectName(objectType string) string {
+	if name, ok := scopeObjectNames[strings.ToLower(objectType)]; ok {
+		return name
+	}
+	if strings.HasPrefix(objectType, "2-") || strings.HasPrefix(objectType, "p_") {
+		return "custom"
+	}
+	return ""
+}
+
+// objectScopes returns the scopes to read and write records of objectType.
+// Tickets are covered by the single tickets scope.
+func objectScopes(objectType string) []string {
+	switch name := scopeObjectName(objectType); name {
+	case "":
+		return nil
+	case "tickets":
+		return []string{"tickets"}
+	default:
+		return []string{"crm.objects." + name + ".read", "crm.objects." + name + ".write"}
+	}
+}
+
+// schemaScopes returns the scopes to read and write the properties and
+// property groups of objectType
+func schemaScopes(objectType string) []string {
+	switch name := scopeObjectName(objectType); name {
+	case "":
+		return nil
+	case "tickets":
+		return []string{"tickets"}
+	default:
+		return []string{"crm.schemas." + name + ".read", "crm.schemas." + name + ".write"}
+	}
+}
+
+// pipelineScopes returns the scopes to manage the pipelines of objectType,
+// which HubSpot grants with the scopes of its records
+func pipelineScopes(objectType string) []string {
+	return objectScopes(objectType)
+}
+
+// checkObjectTypeScopes adds an error listing the scopes the API token lacks
+// for the object types at the given attributes. The scopes of these resources
+// depend on their object types, so they are checked when planning rather
+// than in Configure. The prior state is used when the resource is destroyed.
+func checkObjectTypeScopes(ctx context.Context, c *client.Client, typeName string, req resource.ModifyPlanRequest, diags *diag.Diagnostics, scopes func(objectType string) []string, attributes ...string) {
+	if c == nil {
+		return
+	}
+
+	var required []string
+	for _, attribute := range attributes {
+		var objectType types.String
+		if req.Plan.Raw.IsNull() {
+			diags.Append(req.State.GetAttribute(ctx, path.Root(attribute), &objectType)...)
+		} else {
+			diags.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &objectType)...)
+		}
+		if diags.HasError() {
+			return
+		}
+
+		if objectType.IsNull() || objectType.IsUnknown() {
+			continue
+		}
+		required = append(required, scopes(objectType.ValueString())...)
+	}
+
+	diagnostics.CheckScopes(diags, c, typeName, dedupe(required)...)
+}
+
+// dedupe returns values without repeated entries, keeping the first of each
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}